@@ -9,4 +9,5 @@ var (
 
 	ResourceName   string
 	DatasourceName string
+	ProtoService   string
 )
@@ -19,6 +19,7 @@ var cmd = &cobra.Command{
 			generator.WithTemplateName(generate.Template),
 			generator.WithOverrideFiles(generate.Override),
 			generator.WithSkipComments(generate.SkipComments),
+			generator.WithProtoService(generate.ProtoService),
 		)
 
 		if err := gen.Generate(cmd.Context(), cmd.OutOrStdout()); err != nil {
@@ -33,6 +34,8 @@ var cmd = &cobra.Command{
 func init() {
 	cmd.Flags().StringVar(&generate.ResourceName, "name", "", "set name for generated resource")
 	_ = cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVar(&generate.ProtoService, "proto-service", "",
+		"set full name of the proto service for crud template, e.g. yandex.cloud.vpc.v1.NetworkService. By default it is found by the service and resource names.")
 
 	generate.AddSubCommand(cmd)
 }
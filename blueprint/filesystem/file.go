@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"path"
+	"strings"
 )
 
 // GetPathForGeneratedContent - get valid output path for created content
@@ -32,11 +33,33 @@ func GetPathForGeneratedContent(pathToRepo, tplType, tplName, serviceName, resou
 	return path.Join(parts...)
 }
 
-// WriteContent - copy content from io.Reader to the file with path
-func WriteContent(outputPath string, override bool, content io.Reader) error {
+// GetPathForGeneratedSetContent - get valid output path for the file of a template set.
+// Test files are placed in the test directory of the service, the rest in the package named after the resource.
+func GetPathForGeneratedSetContent(pathToRepo, serviceName, resourceName, fileName string) string {
+	packageName := strings.ReplaceAll(resourceName, "_", "")
+
+	if strings.HasSuffix(fileName, "_test.go") {
+		fileName = fmt.Sprintf("yandex_%s_%s_%s", strings.ReplaceAll(serviceName, "/", "_"), resourceName, fileName)
+		return path.Join(pathToRepo, "yandex-framework", "test", serviceName, packageName, fileName)
+	}
+
+	return path.Join(pathToRepo, "yandex-framework", "services", serviceName, packageName, fileName)
+}
+
+// CheckOverride - check that file with path doesn't exist or could be overridden
+func CheckOverride(outputPath string, override bool) error {
 	if _, err := os.Stat(outputPath); !errors.Is(err, os.ErrNotExist) && !override {
 		return fmt.Errorf("file with path (%s) already exists. Use force flag or delete exists file", outputPath)
 	}
+
+	return nil
+}
+
+// WriteContent - copy content from io.Reader to the file with path
+func WriteContent(outputPath string, override bool, content io.Reader) error {
+	if err := CheckOverride(outputPath, override); err != nil {
+		return err
+	}
 	dir, _ := path.Split(outputPath)
 
 	if err := os.MkdirAll(dir, 0755); err != nil {
@@ -0,0 +1,128 @@
+package filesystem
+
+import (
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	modulePath          = "github.com/yandex-cloud/terraform-provider-yandex"
+	servicesImportPath  = modulePath + "/yandex-framework/services/"
+	resourcesFuncHeader = "func (p *Provider) Resources("
+)
+
+// GetProviderPath - get path of the framework provider, that registers resources
+func GetProviderPath(pathToRepo string) string {
+	return path.Join(pathToRepo, "yandex-framework", "provider", "provider.go")
+}
+
+// RegisterResource - add constructor from the service package to the resources of the framework provider.
+// Returns false if the constructor is already registered.
+func RegisterResource(pathToRepo, serviceName, resourceName, constructor string) (bool, error) {
+	providerPath := GetProviderPath(pathToRepo)
+	src, err := os.ReadFile(providerPath)
+	if err != nil {
+		return false, fmt.Errorf("read provider (%s): %w", providerPath, err)
+	}
+
+	packageName := strings.ReplaceAll(resourceName, "_", "")
+	importPath := servicesImportPath + path.Join(serviceName, packageName)
+
+	alias, imported, err := importAlias(src, importPath, packageName, serviceName)
+	if err != nil {
+		return false, fmt.Errorf("parse provider (%s): %w", providerPath, err)
+	}
+
+	content := string(src)
+	reference := fmt.Sprintf("%s.%s,", alias, constructor)
+
+	start := strings.Index(content, resourcesFuncHeader)
+	if start < 0 {
+		return false, fmt.Errorf("provider (%s) has no Resources method", providerPath)
+	}
+	end := strings.Index(content[start:], "\n\t}\n}")
+	if end < 0 {
+		return false, fmt.Errorf("provider (%s) Resources method has unexpected format", providerPath)
+	}
+	end += start
+
+	if imported && strings.Contains(content[start:end], reference) {
+		return false, nil
+	}
+	content = content[:end] + "\n\t\t" + reference + content[end:]
+
+	if !imported {
+		content, err = addImport(content, alias, packageName, importPath)
+		if err != nil {
+			return false, fmt.Errorf("add import to provider (%s): %w", providerPath, err)
+		}
+	}
+
+	formatted, err := format.Source([]byte(content))
+	if err != nil {
+		return false, fmt.Errorf("format provider (%s): %w", providerPath, err)
+	}
+
+	if err := os.WriteFile(providerPath, formatted, 0644); err != nil {
+		return false, fmt.Errorf("write provider (%s): %w", providerPath, err)
+	}
+
+	return true, nil
+}
+
+// importAlias - get name to refer to the package in the provider by, the package is aliased if its name is taken
+func importAlias(src []byte, importPath, packageName, serviceName string) (string, bool, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, parser.ImportsOnly)
+	if err != nil {
+		return "", false, err
+	}
+
+	taken := map[string]bool{}
+	for _, spec := range file.Imports {
+		p, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return "", false, err
+		}
+
+		name := path.Base(p)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if p == importPath {
+			return name, true, nil
+		}
+		taken[name] = true
+	}
+
+	if !taken[packageName] {
+		return packageName, false, nil
+	}
+	return strings.ReplaceAll(serviceName, "/", "_") + "_" + packageName, false, nil
+}
+
+// addImport - add import after the last import of the service packages
+func addImport(content, alias, packageName, importPath string) (string, error) {
+	spec := strconv.Quote(importPath)
+	if alias != packageName {
+		spec = alias + " " + spec
+	}
+
+	i := strings.LastIndex(content, "\""+servicesImportPath)
+	if i < 0 {
+		i = strings.Index(content, "import (\n")
+		if i < 0 {
+			return "", fmt.Errorf("no import block")
+		}
+		i += len("import (")
+	} else {
+		i += strings.Index(content[i:], "\n")
+	}
+
+	return content[:i] + "\n\t" + spec + content[i:], nil
+}
@@ -0,0 +1,59 @@
+package filesystem
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProvider = `package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb/mongodb/user"
+)
+
+type Provider struct{}
+
+func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		user.NewResource,
+	}
+}
+`
+
+func TestRegisterResource(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	repo := t.TempDir()
+	providerPath := GetProviderPath(repo)
+	require.NoError(t, os.MkdirAll(path.Dir(providerPath), 0755))
+	require.NoError(t, os.WriteFile(providerPath, []byte(testProvider), 0644))
+
+	// Act
+	added, err := RegisterResource(repo, "compute", "disk", "NewIamBinding")
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	collided, err := RegisterResource(repo, "iam", "user", "NewIamBinding")
+	require.NoError(t, err)
+	assert.True(t, collided)
+
+	again, err := RegisterResource(repo, "compute", "disk", "NewIamBinding")
+	require.NoError(t, err)
+
+	// Assert
+	assert.False(t, again)
+	content, err := os.ReadFile(providerPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "\t\"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/compute/disk\"\n")
+	assert.Contains(t, string(content), "\tiam_user \"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/iam/user\"\n")
+	assert.Contains(t, string(content), "\t\tuser.NewResource,\n\t\tdisk.NewIamBinding,\n\t\tiam_user.NewIamBinding,\n\t}\n")
+}
@@ -0,0 +1,719 @@
+package generator
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/api"
+	// go-sdk imports all go-genproto services, so they are registered for the reflection.
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	sdkPackagePath     = "github.com/yandex-cloud/go-sdk"
+	timestampFullName  = "google.protobuf.Timestamp"
+	updateMaskField    = "update_mask"
+	labelsField        = "labels"
+	folderIdField      = "folder_id"
+	nameField          = "name"
+	sdkPathSearchDepth = 3
+)
+
+// crudField is a resource attribute with the code converting it between the terraform model and the proto messages.
+type crudField struct {
+	Name          string
+	GoName        string
+	ModelType     string
+	AttributeType string
+	ElemType      string
+
+	Required        bool
+	Optional        bool
+	Computed        bool
+	RequiresReplace bool
+	PlanModifiers   []string
+
+	// Flatten is the expression of the attribute value of the grpcModel message,
+	// or the function returning the value and the diagnostics for the collections.
+	Flatten      string
+	IsCollection bool
+
+	// Expand is the expression of the request field value of the plan,
+	// or the go type to convert the collection elements to from the ExpandFrom model field.
+	Expand     string
+	ExpandFrom string
+
+	ModelName string
+	TestValue string
+
+	// MessageField is the field of the resource message with the value of the ID field of the request,
+	// VarName is the variable holding the value parsed from the composite resource ID.
+	MessageField string
+	VarName      string
+}
+
+// crudList is the List method of the service used to sweep the test resources.
+type crudList struct {
+	Request     string
+	ParentField string
+	Iterator    string
+}
+
+type crudVars struct {
+	PackageName   string
+	ServiceName   string
+	ResourceName  string
+	TypeName      string
+	Title         string
+	TestName      string
+	ProtoService  string
+	ProtoPackage  string
+	ProtoImport   string
+	SDKPath       string
+	Message       string
+	IdField       crudField
+	GetRequest    string
+	CreateRequest string
+	UpdateRequest string
+	DeleteRequest string
+
+	// IdFields are the fields of the get request identifying the resource. The ID of the resource identified
+	// by a parent ID and a name, e.g. cluster_id and user_name of a database user, is constructed of both of them.
+	IdFields    []crudField
+	CompositeId bool
+	IdVars      string
+
+	// CreateMetadata is the metadata of the create operation, CreateMetadataIds are its fields with the ID fields of the resource.
+	CreateMetadata    string
+	CreateMetadataIds []string
+	CreateResponse    string
+
+	List *crudList
+
+	Fields       []crudField
+	CreateFields []crudField
+	UpdateFields []crudField
+	TestFields   []crudField
+	Skipped      []string
+
+	HasLabels   bool
+	HasFolderId bool
+	HasName     bool
+
+	HasTimestamp         bool
+	HasCollections       bool
+	PlanModifierPackages []string
+	HasCreate            bool
+	HasUpdate            bool
+	HasDelete            bool
+	Sweepable            bool
+	TipIncluded          bool
+}
+
+// resourceCrudVars finds the go-genproto service of the resource and describes its methods and messages for the crud templates.
+func resourceCrudVars(params templateParams) (any, error) {
+	svc, err := findService(params.Service, params.Resource, params.ProtoService)
+	if err != nil {
+		return nil, err
+	}
+
+	get := svc.Methods().ByName("Get")
+	if get == nil {
+		return nil, fmt.Errorf("service %s has no Get method", svc.FullName())
+	}
+
+	resource := get.Output()
+	vars := &crudVars{
+		PackageName:  strings.ReplaceAll(params.Resource, "_", ""),
+		ServiceName:  params.Service,
+		ResourceName: params.Resource,
+		TypeName:     fmt.Sprintf("%s_%s", strings.ReplaceAll(params.Service, "/", "_"), params.Resource),
+		Title:        goTypeName(resource),
+		ProtoService: string(svc.FullName()),
+		ProtoPackage: goPackageName(resource.ParentFile()),
+		ProtoImport:  goType(resource).PkgPath(),
+		Message:      goTypeName(resource),
+		GetRequest:   goTypeName(get.Input()),
+		TipIncluded:  !params.SkipComments,
+	}
+	for _, part := range strings.Split(params.Service, "/") {
+		vars.TestName += toTitle(part)
+	}
+	vars.TestName += vars.Title
+
+	vars.IdFields, err = idFields(get.Input(), resource, params.Resource)
+	if err != nil {
+		return nil, err
+	}
+	vars.IdField = vars.IdFields[0]
+	if len(vars.IdFields) > 1 {
+		vars.CompositeId = true
+		vars.IdVars = vars.IdFields[0].VarName + ", " + vars.IdFields[1].VarName
+	}
+
+	_, sdkPath, ok := findSDKClient("Get", reflect.PointerTo(goType(get.Input())))
+	if !ok {
+		sdkPath = getSdkPath(params.Service, params.Resource)
+	}
+	vars.SDKPath = sdkPath
+
+	vars.collectFields(resource)
+
+	if create := svc.Methods().ByName("Create"); create != nil {
+		vars.HasCreate = true
+		vars.CreateRequest = goTypeName(create.Input())
+		// The parent ID of the composite ID is set by the create request.
+		vars.CreateFields = vars.requestFields(create.Input(), vars.IdFields[len(vars.IdFields)-1:])
+		vars.CreateMetadata, vars.CreateMetadataIds, vars.CreateResponse = operationTypes(create, vars.IdFields)
+	}
+	if update := svc.Methods().ByName("Update"); update != nil {
+		vars.HasUpdate = true
+		vars.UpdateRequest = goTypeName(update.Input())
+		vars.UpdateFields = vars.requestFields(update.Input(), vars.IdFields)
+	}
+	if del := svc.Methods().ByName("Delete"); del != nil {
+		vars.HasDelete = true
+		vars.DeleteRequest = goTypeName(del.Input())
+	}
+	if list := svc.Methods().ByName("List"); list != nil {
+		vars.List = listOf(list)
+	}
+
+	vars.markAttributes()
+	vars.Sweepable = vars.List != nil && vars.List.Iterator != "" && vars.List.ParentField == "FolderId" && vars.HasDelete && vars.HasName &&
+		!vars.CompositeId
+	return vars, nil
+}
+
+// IdOf returns the expression of the resource ID built of the given fields of the message.
+func (v *crudVars) IdOf(message string, fields []string) string {
+	getters := make([]string, 0, len(fields))
+	for _, field := range fields {
+		getters = append(getters, fmt.Sprintf("%s.Get%s()", message, field))
+	}
+	if len(getters) == 1 {
+		return getters[0]
+	}
+	return fmt.Sprintf("resourceid.Construct(%s)", strings.Join(getters, ", "))
+}
+
+// MessageIds returns the fields of the resource message the resource ID is built of.
+func (v *crudVars) MessageIds() []string {
+	fields := make([]string, 0, len(v.IdFields))
+	for _, f := range v.IdFields {
+		fields = append(fields, f.MessageField)
+	}
+	return fields
+}
+
+// findService returns the service with the given full name or named after the resource in the package of the service.
+func findService(service, resource, fullName string) (protoreflect.ServiceDescriptor, error) {
+	if fullName != "" {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(fullName))
+		if err != nil {
+			return nil, fmt.Errorf("find proto service %s: %w", fullName, err)
+		}
+		svc, ok := desc.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a proto service", fullName)
+		}
+		return svc, nil
+	}
+
+	var (
+		name       = protoreflect.Name(snakeToCamel(resource) + "Service")
+		prefix     = fmt.Sprintf("yandex.cloud.%s.", strings.ReplaceAll(service, "/", "."))
+		candidates []protoreflect.ServiceDescriptor
+	)
+	protoregistry.GlobalFiles.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		if !strings.HasPrefix(string(file.Package()), prefix) {
+			return true
+		}
+		if svc := file.Services().ByName(name); svc != nil {
+			candidates = append(candidates, svc)
+		}
+		return true
+	})
+
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("proto service %s not found in packages %s*, set it with --proto-service flag", name, prefix)
+	case 1:
+		return candidates[0], nil
+	}
+
+	names := make([]string, 0, len(candidates))
+	for _, svc := range candidates {
+		names = append(names, string(svc.FullName()))
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("several proto services match the resource: %s, choose one with --proto-service flag", strings.Join(names, ", "))
+}
+
+// findSDKClient returns the go-sdk client having the method with the given request type and its path, e.g. SDK.VPC().Network().
+func findSDKClient(method string, request reflect.Type) (reflect.Type, string, bool) {
+	type node struct {
+		t    reflect.Type
+		path string
+	}
+
+	var (
+		queue   = []node{{t: reflect.TypeOf(&ycsdk.SDK{}), path: "SDK"}}
+		visited = map[reflect.Type]bool{}
+	)
+	for depth := 0; depth <= sdkPathSearchDepth && len(queue) > 0; depth++ {
+		var next []node
+		for _, n := range queue {
+			if m, ok := n.t.MethodByName(method); ok && m.Type.NumIn() > 2 && m.Type.In(2) == request {
+				return n.t, n.path, true
+			}
+
+			for i := 0; i < n.t.NumMethod(); i++ {
+				m := n.t.Method(i)
+				if m.Type.NumIn() != 1 || m.Type.NumOut() != 1 {
+					continue
+				}
+				out := m.Type.Out(0)
+				if out.Kind() != reflect.Ptr || !strings.HasPrefix(out.Elem().PkgPath(), sdkPackagePath) || visited[out] {
+					continue
+				}
+				visited[out] = true
+				next = append(next, node{t: out, path: fmt.Sprintf("%s.%s()", n.path, m.Name)})
+			}
+		}
+		queue = next
+	}
+
+	return nil, "", false
+}
+
+// collectFields adds the attributes for the fields of the resource message.
+func (v *crudVars) collectFields(resource protoreflect.MessageDescriptor) {
+	fields := resource.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Name() == "id" {
+			continue
+		}
+
+		f, ok := v.convertField(resource, fd)
+		if !ok {
+			v.Skipped = append(v.Skipped, string(fd.Name()))
+			continue
+		}
+		if fd.Name() == labelsField && fd.IsMap() {
+			v.HasLabels = true
+		}
+		if fd.Name() == folderIdField {
+			v.HasFolderId = true
+		}
+		if fd.Name() == nameField {
+			v.HasName = true
+		}
+		if strings.Contains(f.Flatten, "timestamp.Get") {
+			v.HasTimestamp = true
+		}
+		if f.IsCollection {
+			v.HasCollections = true
+		}
+		f.Computed = true
+		v.Fields = append(v.Fields, f)
+	}
+}
+
+// requestFields returns the fields of the create or update request matching the resource attributes.
+// The ID fields are set from the resource ID, not from the attributes.
+func (v *crudVars) requestFields(request protoreflect.MessageDescriptor, ids []crudField) []crudField {
+	var result []crudField
+
+	fields := request.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if isIdField(fd, ids) || fd.Name() == updateMaskField {
+			continue
+		}
+
+		f, ok := v.convertField(request, fd)
+		if !ok || f.Expand == "" {
+			v.skip(string(request.Name()) + "." + string(fd.Name()))
+			continue
+		}
+		if v.attribute(f.Name) == nil {
+			v.skip(string(request.Name()) + "." + string(fd.Name()))
+			continue
+		}
+		if f.Name == labelsField && v.HasLabels {
+			f.ExpandFrom = "EffectiveLabels"
+		}
+		if proto.GetExtension(fd.Options(), cloud.E_Required).(bool) {
+			f.Required = true
+		}
+		result = append(result, f)
+	}
+
+	return result
+}
+
+// markAttributes makes the attributes set by the create request configurable and the ones not updatable replacing the resource.
+func (v *crudVars) markAttributes() {
+	updatable := map[string]bool{}
+	for _, f := range v.UpdateFields {
+		updatable[f.Name] = true
+	}
+
+	for _, f := range v.CreateFields {
+		attr := v.attribute(f.Name)
+		switch {
+		case f.Required && f.Name != folderIdField:
+			attr.Required, attr.Computed = true, false
+		case f.IsCollection:
+			attr.Optional, attr.Computed = true, false
+		default:
+			attr.Optional = true
+		}
+		attr.RequiresReplace = !updatable[f.Name]
+		if attr.Required && f.Name != nameField {
+			v.TestFields = append(v.TestFields, *attr)
+		}
+	}
+
+	packages := map[string]bool{}
+	for i := range v.Fields {
+		attr := &v.Fields[i]
+		modifier := strings.ToLower(attr.AttributeType) + "planmodifier"
+		if attr.Computed && attr.AttributeType == "String" && (attr.Optional || attr.Name == "created_at") {
+			attr.PlanModifiers = append(attr.PlanModifiers, modifier+".UseStateForUnknown()")
+		}
+		if attr.RequiresReplace {
+			attr.PlanModifiers = append(attr.PlanModifiers, modifier+".RequiresReplace()")
+		}
+		if len(attr.PlanModifiers) > 0 {
+			packages[modifier] = true
+		}
+	}
+
+	v.PlanModifierPackages = []string{"stringplanmodifier"}
+	for p := range packages {
+		if p != "stringplanmodifier" {
+			v.PlanModifierPackages = append(v.PlanModifierPackages, p)
+		}
+	}
+	sort.Strings(v.PlanModifierPackages)
+}
+
+func (v *crudVars) attribute(name string) *crudField {
+	for i := range v.Fields {
+		if v.Fields[i].Name == name {
+			return &v.Fields[i]
+		}
+	}
+	return nil
+}
+
+func (v *crudVars) skip(name string) {
+	for _, s := range v.Skipped {
+		if s == name {
+			return
+		}
+	}
+	v.Skipped = append(v.Skipped, name)
+}
+
+// convertField returns the attribute of the proto field, if its type is supported.
+func (v *crudVars) convertField(msg protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor) (crudField, bool) {
+	if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+		return crudField{}, false
+	}
+
+	f := crudField{Name: string(fd.Name()), GoName: goFieldName(msg, fd), ModelName: snakeToCamel(string(fd.Name()))}
+	f.ExpandFrom = f.ModelName
+	getter := fmt.Sprintf("grpcModel.Get%s()", f.GoName)
+
+	switch {
+	case fd.IsMap():
+		if fd.MapKey().Kind() != protoreflect.StringKind || fd.MapValue().Kind() != protoreflect.StringKind {
+			return crudField{}, false
+		}
+		f.ModelType, f.AttributeType, f.ElemType, f.TestValue = "types.Map", "Map", "types.StringType", "{}"
+		f.Flatten = fmt.Sprintf("types.MapValueFrom(ctx, types.StringType, %s)", getter)
+		f.Expand = "map[string]string"
+		f.IsCollection = true
+	case fd.IsList():
+		if fd.Kind() != protoreflect.StringKind {
+			return crudField{}, false
+		}
+		f.ModelType, f.AttributeType, f.ElemType, f.TestValue = "types.List", "List", "types.StringType", "[]"
+		f.Flatten = fmt.Sprintf("types.ListValueFrom(ctx, types.StringType, %s)", getter)
+		f.Expand = "[]string"
+		f.IsCollection = true
+	default:
+		return v.convertScalar(f, fd, getter)
+	}
+
+	return f, true
+}
+
+func (v *crudVars) convertScalar(f crudField, fd protoreflect.FieldDescriptor, getter string) (crudField, bool) {
+	value := "plan." + f.ExpandFrom
+
+	f.TestValue = map[protoreflect.Kind]string{protoreflect.StringKind: `""`, protoreflect.BoolKind: "false"}[fd.Kind()]
+	if f.TestValue == "" {
+		f.TestValue = "0"
+	}
+
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		f.ModelType, f.AttributeType = "types.String", "String"
+		f.Flatten = fmt.Sprintf("types.StringValue(%s)", getter)
+		f.Expand = value + ".ValueString()"
+	case protoreflect.BoolKind:
+		f.ModelType, f.AttributeType = "types.Bool", "Bool"
+		f.Flatten = fmt.Sprintf("types.BoolValue(%s)", getter)
+		f.Expand = value + ".ValueBool()"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		f.ModelType, f.AttributeType = "types.Int64", "Int64"
+		f.Flatten = fmt.Sprintf("types.Int64Value(%s)", getter)
+		f.Expand = value + ".ValueInt64()"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		goKind := map[protoreflect.Kind]string{
+			protoreflect.Int32Kind: "int32", protoreflect.Sint32Kind: "int32", protoreflect.Sfixed32Kind: "int32",
+			protoreflect.Uint32Kind: "uint32", protoreflect.Fixed32Kind: "uint32",
+			protoreflect.Uint64Kind: "uint64", protoreflect.Fixed64Kind: "uint64",
+		}[fd.Kind()]
+		f.ModelType, f.AttributeType = "types.Int64", "Int64"
+		f.Flatten = fmt.Sprintf("types.Int64Value(int64(%s))", getter)
+		f.Expand = fmt.Sprintf("%s(%s.ValueInt64())", goKind, value)
+	case protoreflect.DoubleKind, protoreflect.FloatKind:
+		f.ModelType, f.AttributeType = "types.Float64", "Float64"
+		f.Flatten = fmt.Sprintf("types.Float64Value(float64(%s))", getter)
+		f.Expand = value + ".ValueFloat64()"
+		if fd.Kind() == protoreflect.FloatKind {
+			f.Expand = fmt.Sprintf("float32(%s)", f.Expand)
+		}
+	case protoreflect.EnumKind:
+		f.ModelType, f.AttributeType, f.TestValue = "types.String", "String", `""`
+		f.Flatten = fmt.Sprintf("types.StringValue(%s.String())", getter)
+		if goPackageName(fd.Enum().ParentFile()) == v.ProtoPackage && goType(fd.Enum()).PkgPath() == v.ProtoImport {
+			enum := fmt.Sprintf("%s.%s", v.ProtoPackage, goType(fd.Enum()).Name())
+			f.Expand = fmt.Sprintf("%s(%s_value[%s.ValueString()])", enum, enum, value)
+		}
+	case protoreflect.MessageKind:
+		if fd.Message().FullName() != timestampFullName {
+			return crudField{}, false
+		}
+		f.ModelType, f.AttributeType, f.TestValue = "types.String", "String", `""`
+		f.Flatten = fmt.Sprintf("types.StringValue(timestamp.Get(%s))", getter)
+	default:
+		return crudField{}, false
+	}
+
+	return f, true
+}
+
+// operationTypes returns the metadata of the operation with the fields holding the resource ID fields and the response type.
+func operationTypes(method protoreflect.MethodDescriptor, ids []crudField) (metadata string, metadataIds []string, response string) {
+	op, ok := proto.GetExtension(method.Options(), api.E_Operation).(*api.Operation)
+	if !ok || op == nil {
+		return "", nil, ""
+	}
+
+	for _, name := range []string{op.GetMetadata(), op.GetResponse()} {
+		if name == "" {
+			continue
+		}
+		if !strings.Contains(name, ".") {
+			name = string(method.ParentFile().Package()) + "." + name
+		}
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(name))
+		if err != nil {
+			continue
+		}
+
+		desc := mt.Descriptor()
+		if name == op.GetMetadata() || strings.HasSuffix(name, "."+op.GetMetadata()) {
+			metadata = goTypeName(desc)
+			for _, id := range ids {
+				fd := desc.Fields().ByName(protoreflect.Name(id.Name))
+				if fd == nil {
+					metadataIds = nil
+					break
+				}
+				metadataIds = append(metadataIds, goFieldName(desc, fd))
+			}
+		} else {
+			response = goTypeName(desc)
+		}
+	}
+
+	return metadata, metadataIds, response
+}
+
+// listOf returns the List method with the field of the request limiting the resources, e.g. folder_id.
+func listOf(method protoreflect.MethodDescriptor) *crudList {
+	parent := idField(method.Input())
+	if parent == nil {
+		return nil
+	}
+
+	list := &crudList{
+		Request:     goTypeName(method.Input()),
+		ParentField: goFieldName(method.Input(), parent),
+	}
+
+	request := reflect.PointerTo(goType(method.Input()))
+	client, _, ok := findSDKClient("List", request)
+	if !ok {
+		return list
+	}
+	for i := 0; i < client.NumMethod(); i++ {
+		m := client.Method(i)
+		if strings.HasSuffix(m.Name, "Iterator") && m.Type.NumIn() > 2 && m.Type.In(2) == request {
+			list.Iterator = m.Name
+		}
+	}
+
+	return list
+}
+
+// idFields returns the fields of the get request identifying the resource: either a single ID field,
+// e.g. network_id of GetNetworkRequest, or the parent ID and the name, e.g. cluster_id and user_name of GetUserRequest.
+func idFields(request, resource protoreflect.MessageDescriptor, resourceName string) ([]crudField, error) {
+	var required []protoreflect.FieldDescriptor
+	fields := request.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() == protoreflect.StringKind && proto.GetExtension(fd.Options(), cloud.E_Required).(bool) {
+			required = append(required, fd)
+		}
+	}
+
+	switch len(required) {
+	case 0, 1:
+		id := idField(request)
+		if id == nil {
+			return nil, fmt.Errorf("request %s has no resource ID field", request.FullName())
+		}
+		f := crudField{Name: string(id.Name()), GoName: goFieldName(request, id), MessageField: "Id"}
+		if fd := resource.Fields().ByName("id"); fd != nil {
+			f.MessageField = goFieldName(resource, fd)
+		}
+		return []crudField{f}, nil
+	case 2:
+	default:
+		return nil, fmt.Errorf("request %s identifies the resource by %d fields, only a single ID or a parent ID and a name are supported",
+			request.FullName(), len(required))
+	}
+
+	result := make([]crudField, 0, len(required))
+	for _, fd := range required {
+		// The fields are named either as the fields of the resource, e.g. cluster_id, or after the resource, e.g. user_name.
+		name := string(fd.Name())
+		messageField := resource.Fields().ByName(protoreflect.Name(name))
+		if messageField == nil {
+			messageField = resource.Fields().ByName(protoreflect.Name(strings.TrimPrefix(name, resourceName+"_")))
+		}
+		if messageField == nil || messageField.Kind() != protoreflect.StringKind {
+			return nil, fmt.Errorf("field %s of request %s has no matching field in %s, the resource ID can not be constructed",
+				name, request.FullName(), resource.FullName())
+		}
+
+		goName := goFieldName(request, fd)
+		result = append(result, crudField{
+			Name:         name,
+			GoName:       goName,
+			MessageField: goFieldName(resource, messageField),
+			VarName:      strings.ToLower(goName[:1]) + goName[1:],
+		})
+	}
+	return result, nil
+}
+
+func isIdField(fd protoreflect.FieldDescriptor, ids []crudField) bool {
+	for _, id := range ids {
+		if string(fd.Name()) == id.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// idField returns the first field of the request with an ID, e.g. network_id of GetNetworkRequest.
+func idField(request protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	fields := request.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if fd := fields.Get(i); fd.Kind() == protoreflect.StringKind && strings.HasSuffix(string(fd.Name()), "_id") {
+			return fd
+		}
+	}
+	return nil
+}
+
+// goType returns the go type generated for the proto message or enum, e.g. vpc.Network for yandex.cloud.vpc.v1.Network.
+func goType(desc protoreflect.Descriptor) reflect.Type {
+	switch d := desc.(type) {
+	case protoreflect.MessageDescriptor:
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(d.FullName())
+		if err != nil {
+			return nil
+		}
+		return reflect.TypeOf(mt.Zero().Interface()).Elem()
+	case protoreflect.EnumDescriptor:
+		et, err := protoregistry.GlobalTypes.FindEnumByName(d.FullName())
+		if err != nil {
+			return nil
+		}
+		return reflect.TypeOf(et.New(0))
+	}
+	return nil
+}
+
+func goTypeName(desc protoreflect.MessageDescriptor) string {
+	if t := goType(desc); t != nil {
+		return t.Name()
+	}
+	return string(desc.Name())
+}
+
+// goFieldName returns the name of the go struct field generated for the proto field.
+func goFieldName(msg protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor) string {
+	t := goType(msg)
+	if t == nil {
+		return snakeToCamel(string(fd.Name()))
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		for _, part := range strings.Split(t.Field(i).Tag.Get("protobuf"), ",") {
+			if part == "name="+string(fd.Name()) {
+				return t.Field(i).Name
+			}
+		}
+	}
+	return snakeToCamel(string(fd.Name()))
+}
+
+// goPackageName returns the name of the go package generated for the proto file.
+func goPackageName(file protoreflect.FileDescriptor) string {
+	goPackage := file.Options().(*descriptorpb.FileOptions).GetGoPackage()
+	if i := strings.LastIndex(goPackage, ";"); i >= 0 {
+		return goPackage[i+1:]
+	}
+	return goPackage[strings.LastIndex(goPackage, "/")+1:]
+}
+
+func snakeToCamel(s string) string {
+	parts := strings.Split(s, "_")
+	for i, part := range parts {
+		if part != "" {
+			parts[i] = strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,293 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/blueprint/filesystem"
+	"github.com/yandex-cloud/terraform-provider-yandex/blueprint/templates"
+)
+
+func Test_resourceCrudVars(t *testing.T) {
+	t.Parallel()
+
+	// Arrange, Act
+	raw, err := resourceCrudVars(templateParams{Service: "vpc", Resource: "network"})
+	require.NoError(t, err)
+	vars := raw.(*crudVars)
+
+	// Assert
+	assert.Equal(t, "yandex.cloud.vpc.v1.NetworkService", vars.ProtoService)
+	assert.Equal(t, "github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1", vars.ProtoImport)
+	assert.Equal(t, "vpc", vars.ProtoPackage)
+	assert.Equal(t, "SDK.VPC().Network()", vars.SDKPath)
+	assert.Equal(t, "vpc_network", vars.TypeName)
+	assert.Equal(t, "NetworkId", vars.IdField.GoName)
+	assert.Equal(t, "CreateNetworkMetadata", vars.CreateMetadata)
+	assert.Equal(t, []string{"NetworkId"}, vars.CreateMetadataIds)
+	assert.Equal(t, &crudList{Request: "ListNetworksRequest", ParentField: "FolderId", Iterator: "NetworkIterator"}, vars.List)
+	assert.True(t, vars.HasLabels)
+	assert.True(t, vars.Sweepable)
+
+	var updatable []string
+	for _, f := range vars.UpdateFields {
+		updatable = append(updatable, f.Name)
+	}
+	assert.Equal(t, []string{"name", "description", "labels"}, updatable)
+	assert.Equal(t, "EffectiveLabels", vars.UpdateFields[2].ExpandFrom)
+
+	folder := vars.attribute("folder_id")
+	require.NotNil(t, folder)
+	assert.True(t, folder.Optional)
+	assert.True(t, folder.Computed)
+	assert.True(t, folder.RequiresReplace)
+
+	name := vars.attribute("name")
+	require.NotNil(t, name)
+	assert.False(t, name.RequiresReplace)
+
+	createdAt := vars.attribute("created_at")
+	require.NotNil(t, createdAt)
+	assert.False(t, createdAt.Optional)
+	assert.Equal(t, "types.StringValue(timestamp.Get(grpcModel.GetCreatedAt()))", createdAt.Flatten)
+}
+
+func Test_resourceCrudVars_requiredAndSkipped(t *testing.T) {
+	t.Parallel()
+
+	// Arrange, Act
+	raw, err := resourceCrudVars(templateParams{Service: "compute", Resource: "disk"})
+	require.NoError(t, err)
+	vars := raw.(*crudVars)
+
+	// Assert
+	assert.Equal(t, "SDK.Compute().Disk()", vars.SDKPath)
+
+	zone := vars.attribute("zone_id")
+	require.NotNil(t, zone)
+	assert.True(t, zone.Required)
+	assert.True(t, zone.RequiresReplace)
+
+	size := vars.attribute("size")
+	require.NotNil(t, size)
+	assert.True(t, size.Required)
+	assert.False(t, size.RequiresReplace)
+	assert.Equal(t, "plan.Size.ValueInt64()", size.Expand)
+
+	status := vars.attribute("status")
+	require.NotNil(t, status)
+	assert.Equal(t, "types.StringValue(grpcModel.GetStatus().String())", status.Flatten)
+
+	assert.Contains(t, vars.Skipped, "disk_placement_policy")
+}
+
+func Test_resourceCrudVars_compositeId(t *testing.T) {
+	t.Parallel()
+
+	// Arrange, Act
+	raw, err := resourceCrudVars(templateParams{Service: "mdb/postgresql", Resource: "user"})
+	require.NoError(t, err)
+	vars := raw.(*crudVars)
+
+	// Assert
+	assert.True(t, vars.CompositeId)
+	assert.Equal(t, "clusterId, userName", vars.IdVars)
+	assert.Equal(t, []string{"ClusterId", "Name"}, vars.MessageIds())
+	assert.Equal(t, "resourceid.Construct(grpcModel.GetClusterId(), grpcModel.GetName())", vars.IdOf("grpcModel", vars.MessageIds()))
+	assert.Equal(t, []string{"ClusterId", "UserName"}, vars.CreateMetadataIds)
+	assert.False(t, vars.Sweepable)
+
+	cluster := vars.attribute("cluster_id")
+	require.NotNil(t, cluster)
+	assert.True(t, cluster.Required)
+	assert.True(t, cluster.RequiresReplace)
+
+	for _, f := range vars.UpdateFields {
+		assert.NotContains(t, []string{"cluster_id", "user_name"}, f.Name)
+	}
+}
+
+func Test_resourceCrudVars_unsupportedId(t *testing.T) {
+	t.Parallel()
+
+	// Arrange, Act
+	_, err := resourceCrudVars(templateParams{Service: "apploadbalancer", Resource: "virtual_host"})
+
+	// Assert
+	assert.EqualError(t, err, "field http_router_id of request yandex.cloud.apploadbalancer.v1.GetVirtualHostRequest has no matching field "+
+		"in yandex.cloud.apploadbalancer.v1.VirtualHost, the resource ID can not be constructed")
+}
+
+func Test_findService(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		service   string
+		resource  string
+		fullName  string
+		want      string
+		wantError string
+	}{
+		{
+			name:     "case: found by service and resource",
+			service:  "vpc",
+			resource: "security_group",
+			want:     "yandex.cloud.vpc.v1.SecurityGroupService",
+		},
+		{
+			name:     "case: nested service",
+			service:  "mdb/postgresql",
+			resource: "user",
+			want:     "yandex.cloud.mdb.postgresql.v1.UserService",
+		},
+		{
+			name:     "case: full name",
+			service:  "mdb",
+			resource: "cluster",
+			fullName: "yandex.cloud.mdb.mysql.v1.ClusterService",
+			want:     "yandex.cloud.mdb.mysql.v1.ClusterService",
+		},
+		{
+			name:      "case: not found",
+			service:   "vpc",
+			resource:  "unknown",
+			wantError: "proto service UnknownService not found",
+		},
+		{
+			name:      "case: not a service",
+			fullName:  "yandex.cloud.vpc.v1.Network",
+			wantError: "is not a proto service",
+		},
+	}
+	for _, tt := range tests {
+
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange, Act
+			svc, err := findService(tt.service, tt.resource, tt.fullName)
+
+			// Assert
+			if tt.wantError != "" {
+				assert.ErrorContains(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(svc.FullName()))
+		})
+	}
+}
+
+func TestGenerator_crudTemplates(t *testing.T) {
+	t.Parallel()
+
+	files, err := templates.ListSet(fs, "crud", "resource")
+	require.NoError(t, err)
+	assert.Contains(t, files, "update_mask.go")
+	assert.Contains(t, files, "resource_test.go")
+
+	for _, resource := range []string{"network", "security_group", "route_table"} {
+		for _, skipComments := range []bool{true, false} {
+			gen := New("vpc", resource, WithTemplateType("resource"), WithTemplateName("crud"), WithSkipComments(skipComments))
+			vars, err := gen.variables()
+			require.NoError(t, err)
+
+			for _, file := range files {
+				t.Run(fmt.Sprintf("%s/%s/%t", resource, file, skipComments), func(t *testing.T) {
+					// Act, formatting fails if the generated code is not valid go
+					content, err := gen.execute("resource/crud", file, vars)
+
+					// Assert
+					require.NoError(t, err)
+					source, err := io.ReadAll(content)
+					require.NoError(t, err)
+					assert.Contains(t, string(source), "package "+vars.(*crudVars).PackageName)
+				})
+			}
+		}
+	}
+}
+
+func TestGenerator_crudTemplatesCompile(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("builds the generated packages with the go command")
+	}
+
+	repo, err := filepath.Abs(filepath.Join("..", ".."))
+	require.NoError(t, err)
+	files, err := templates.ListSet(fs, "crud", "resource")
+	require.NoError(t, err)
+
+	for _, tt := range []struct{ service, resource string }{
+		{service: "vpc", resource: "network"},
+		{service: "mdb/postgresql", resource: "user"},
+	} {
+		tt := tt
+		t.Run(tt.service+"/"+tt.resource, func(t *testing.T) {
+			t.Parallel()
+
+			gen := New(tt.service, tt.resource, WithTemplateType("resource"), WithTemplateName("crud"))
+			vars, err := gen.variables()
+			require.NoError(t, err)
+
+			// The generated files are placed into the module by the overlay, without writing them into the repository.
+			// The generated files are placed into the module by the overlay, without writing them into the repository.
+			var (
+				root    = filepath.Join(repo, "blueprint", "generator", "testdata", "generated")
+				dir     = t.TempDir()
+				overlay = map[string]string{}
+				pkg     string
+				testPkg string
+			)
+			for _, file := range files {
+				content, err := gen.execute("resource/crud", file, vars)
+				require.NoError(t, err)
+				source, err := io.ReadAll(content)
+				require.NoError(t, err)
+
+				tmpPath := filepath.Join(dir, file)
+				require.NoError(t, os.WriteFile(tmpPath, source, 0600))
+				outputPath := filesystem.GetPathForGeneratedSetContent(root, tt.service, tt.resource, file)
+				overlay[outputPath] = tmpPath
+
+				rel, err := filepath.Rel(repo, filepath.Dir(outputPath))
+				require.NoError(t, err)
+				if strings.HasSuffix(file, "_test.go") {
+					testPkg = "./" + filepath.ToSlash(rel)
+				} else {
+					pkg = "./" + filepath.ToSlash(rel)
+				}
+			}
+
+			overlayPath := filepath.Join(dir, "overlay.json")
+			data, err := json.Marshal(map[string]any{"Replace": overlay})
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(overlayPath, data, 0600))
+
+			// Act, the generated package and its acceptance tests are compiled
+			for _, args := range [][]string{
+				{"build", "-overlay", overlayPath, "-o", os.DevNull, pkg},
+				{"test", "-c", "-vet=off", "-overlay", overlayPath, "-o", filepath.Join(dir, "test"), testPkg},
+			} {
+				cmd := exec.Command("go", args...)
+				cmd.Dir = repo
+				output, err := cmd.CombinedOutput()
+
+				// Assert
+				assert.NoError(t, err, string(output))
+			}
+		})
+	}
+}
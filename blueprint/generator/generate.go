@@ -42,11 +42,24 @@ func WithSkipComments(skip bool) Opts {
 	}
 }
 
+// WithProtoService - set full name of the proto service of the resource, e.g. yandex.cloud.vpc.v1.NetworkService
+func WithProtoService(name string) Opts {
+	return func(generator *Generator) {
+		generator.protoService = name
+	}
+}
+
+// templateRegistrations - constructors of the template sets, that are registered in the provider resources
+var templateRegistrations = map[string][]string{
+	"resource-iam_binding": {"NewIamBinding", "NewIamMember", "NewIamPolicy"},
+}
+
 type Generator struct {
 	tplType      string
 	tplName      string
 	resourceName string
 	serviceName  string
+	protoService string
 	tplVars      any
 	override     bool
 	skipComments bool
@@ -72,6 +85,10 @@ func (g *Generator) Generate(_ context.Context, output io.Writer) error {
 		"Start generating %s from template: %s for service: %s entity: %s ... \n",
 		g.tplType, g.tplName, g.serviceName, g.resourceName,
 	)
+	if templates.IsSet(fs, g.tplName, g.tplType) {
+		return g.generateSet(output)
+	}
+
 	content, err := g.generate()
 	if err != nil {
 		return fmt.Errorf("generate main file: %w", err)
@@ -91,19 +108,83 @@ func (g *Generator) generate() (io.Reader, error) {
 		return nil, fmt.Errorf("template with provided name (%s) and type (%s) doesn't exist", g.tplName, g.tplType)
 	}
 
-	content, err := templates.Generate(
-		fs,
-		g.tplType,
-		g.tplName,
-		variablesForTemplate(g.tplType, g.tplName, g.serviceName, g.resourceName, g.skipComments),
-	)
+	vars, err := g.variables()
+	if err != nil {
+		return nil, err
+	}
+
+	return g.execute(g.tplType, g.tplName, vars)
+}
+
+// generateSet - generate all files of the template set before saving any of them
+func (g *Generator) generateSet(output io.Writer) error {
+	files, err := templates.ListSet(fs, g.tplName, g.tplType)
+	if err != nil {
+		return err
+	}
+
+	vars, err := g.variables()
+	if err != nil {
+		return err
+	}
+
+	contents := make(map[string]io.Reader, len(files))
+	for _, file := range files {
+		contents[file], err = g.execute(fmt.Sprintf("%s/%s", g.tplType, g.tplName), file, vars)
+		if err != nil {
+			return fmt.Errorf("generate file (%s): %w", file, err)
+		}
+
+		outputPath := filesystem.GetPathForGeneratedSetContent(generate.PathToRepo, g.serviceName, g.resourceName, file)
+		if err := filesystem.CheckOverride(outputPath, g.override); err != nil {
+			return err
+		}
+	}
+
+	for _, file := range files {
+		outputPath := filesystem.GetPathForGeneratedSetContent(generate.PathToRepo, g.serviceName, g.resourceName, file)
+		if err := filesystem.WriteContent(outputPath, g.override, contents[file]); err != nil {
+			return fmt.Errorf("write generated template to file (%s): %w", outputPath, err)
+		}
+		_, _ = fmt.Fprintf(output, "File sucessfully generated and placed by path: %s \n", outputPath)
+	}
+
+	for _, constructor := range templateRegistrations[fmt.Sprintf("%s-%s", g.tplType, g.tplName)] {
+		registered, err := filesystem.RegisterResource(generate.PathToRepo, g.serviceName, g.resourceName, constructor)
+		if err != nil {
+			return fmt.Errorf("register %s: %w", constructor, err)
+		}
+		if registered {
+			_, _ = fmt.Fprintf(output, "%s sucessfully registered in: %s \n", constructor, filesystem.GetProviderPath(generate.PathToRepo))
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) variables() (any, error) {
+	vars, err := variablesForTemplate(g.tplType, g.tplName, templateParams{
+		Service:      g.serviceName,
+		Resource:     g.resourceName,
+		ProtoService: g.protoService,
+		SkipComments: g.skipComments,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("prepare variables for template (%s) : %w", g.tplName, err)
+	}
+
+	return vars, nil
+}
+
+func (g *Generator) execute(tplType, tplName string, vars any) (io.Reader, error) {
+	content, err := templates.Generate(fs, tplType, tplName, vars)
 	if err != nil {
-		return nil, fmt.Errorf("generate template (%s) : %w", g.tplName, err)
+		return nil, fmt.Errorf("generate template (%s) : %w", tplName, err)
 	}
 
 	formattedContent, err := templates.Format(content)
 	if err != nil {
-		return nil, fmt.Errorf("generate template (%s) : %w", g.tplName, err)
+		return nil, fmt.Errorf("generate template (%s) : %w", tplName, err)
 	}
 
 	return formattedContent, nil
@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type iamBindingVars struct {
+	PackageName  string
+	ServiceName  string
+	ResourceName string
+	TypeName     string
+	Title        string
+	TestName     string
+	ProtoService string
+	SDKPath      string
+	IdAlias      string
+
+	HasSetAccessBindings bool
+	TipIncluded          bool
+}
+
+// resourceIamBindingVars finds the go-genproto service of the resource and checks that it manages the access bindings.
+func resourceIamBindingVars(params templateParams) (any, error) {
+	svc, err := findService(params.Service, params.Resource, params.ProtoService)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, method := range []protoreflect.Name{"ListAccessBindings", "UpdateAccessBindings"} {
+		if svc.Methods().ByName(method) == nil {
+			return nil, fmt.Errorf("service %s has no %s method", svc.FullName(), method)
+		}
+	}
+
+	vars := &iamBindingVars{
+		PackageName:          strings.ReplaceAll(params.Resource, "_", ""),
+		ServiceName:          params.Service,
+		ResourceName:         params.Resource,
+		TypeName:             fmt.Sprintf("%s_%s", strings.ReplaceAll(params.Service, "/", "_"), params.Resource),
+		Title:                snakeToCamel(params.Resource),
+		ProtoService:         string(svc.FullName()),
+		SDKPath:              getSdkPath(params.Service, params.Resource),
+		IdAlias:              params.Resource + "_id",
+		HasSetAccessBindings: svc.Methods().ByName("SetAccessBindings") != nil,
+		TipIncluded:          !params.SkipComments,
+	}
+
+	// The access binding requests are common for all services, so the client is found by the Get request of the resource.
+	if get := svc.Methods().ByName("Get"); get != nil {
+		vars.Title = goTypeName(get.Output())
+		if id := idField(get.Input()); id != nil {
+			vars.IdAlias = string(id.Name())
+		}
+		if _, sdkPath, ok := findSDKClient("Get", reflect.PointerTo(goType(get.Input()))); ok {
+			vars.SDKPath = sdkPath
+		}
+	}
+
+	for _, part := range strings.Split(params.Service, "/") {
+		vars.TestName += toTitle(part)
+	}
+	vars.TestName += vars.Title
+
+	return vars, nil
+}
@@ -0,0 +1,65 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/blueprint/templates"
+)
+
+func Test_resourceIamBindingVars(t *testing.T) {
+	t.Parallel()
+
+	// Arrange, Act
+	raw, err := resourceIamBindingVars(templateParams{Service: "compute", Resource: "disk"})
+	require.NoError(t, err)
+	vars := raw.(*iamBindingVars)
+
+	// Assert
+	assert.Equal(t, "yandex.cloud.compute.v1.DiskService", vars.ProtoService)
+	assert.Equal(t, "SDK.Compute().Disk()", vars.SDKPath)
+	assert.Equal(t, "disk_id", vars.IdAlias)
+	assert.Equal(t, "Disk", vars.Title)
+	assert.Equal(t, "ComputeDisk", vars.TestName)
+	assert.Equal(t, "compute_disk", vars.TypeName)
+}
+
+func Test_resourceIamBindingVars_noAccessBindings(t *testing.T) {
+	t.Parallel()
+
+	// Arrange, Act
+	_, err := resourceIamBindingVars(templateParams{Service: "vpc", Resource: "network"})
+
+	// Assert
+	assert.ErrorContains(t, err, "has no ListAccessBindings method")
+}
+
+func TestGenerator_iamBindingTemplates(t *testing.T) {
+	t.Parallel()
+
+	files, err := templates.ListSet(fs, "iam_binding", "resource")
+	require.NoError(t, err)
+
+	for _, resource := range []string{"disk", "instance", "snapshot"} {
+		gen := New("compute", resource, WithTemplateType("resource"), WithTemplateName("iam_binding"))
+		vars, err := gen.variables()
+		require.NoError(t, err)
+
+		for _, file := range files {
+			t.Run(fmt.Sprintf("%s/%s", resource, file), func(t *testing.T) {
+				// Act, formatting fails if the generated code is not valid go
+				content, err := gen.execute("resource/iam_binding", file, vars)
+
+				// Assert
+				require.NoError(t, err)
+				source, err := io.ReadAll(content)
+				require.NoError(t, err)
+				assert.Contains(t, string(source), "package ")
+			})
+		}
+	}
+}
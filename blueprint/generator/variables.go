@@ -5,17 +5,31 @@ import (
 	"strings"
 )
 
-type variablesGenerator func(service, resource string, skipComments bool) any
+// templateParams are the values the template variables are generated from.
+type templateParams struct {
+	Service      string
+	Resource     string
+	ProtoService string
+	SkipComments bool
+}
+
+type variablesGenerator func(params templateParams) (any, error)
 
 var templateVariables = map[string]variablesGenerator{
-	"resource-iam_member": resourceIamVars,
+	"resource-iam_member":  resourceIamVars,
+	"resource-crud":        resourceCrudVars,
+	"resource-iam_binding": resourceIamBindingVars,
 }
 
-func variablesForTemplate(tplType, tplName, service, resource string, skipComments bool) any {
-	return templateVariables[fmt.Sprintf("%s-%s", tplType, tplName)](service, resource, skipComments)
+func variablesForTemplate(tplType, tplName string, params templateParams) (any, error) {
+	generate, ok := templateVariables[fmt.Sprintf("%s-%s", tplType, tplName)]
+	if !ok {
+		return nil, fmt.Errorf("no variables for template with name (%s) and type (%s)", tplName, tplType)
+	}
+	return generate(params)
 }
 
-func resourceIamVars(service, resource string, skipComments bool) any {
+func resourceIamVars(params templateParams) (any, error) {
 	return struct {
 		PackageName       string
 		ServiceName       string
@@ -23,12 +37,12 @@ func resourceIamVars(service, resource string, skipComments bool) any {
 		SDKPath           string
 		TipIncluded       bool
 	}{
-		PackageName:       resource,
-		ServiceName:       service,
-		PublicPackageName: toTitle(resource),
-		SDKPath:           getSdkPath(service, resource),
-		TipIncluded:       !skipComments,
-	}
+		PackageName:       params.Resource,
+		ServiceName:       params.Service,
+		PublicPackageName: toTitle(params.Resource),
+		SDKPath:           getSdkPath(params.Service, params.Resource),
+		TipIncluded:       !params.SkipComments,
+	}, nil
 }
 
 func getSdkPath(service, resource string) string {
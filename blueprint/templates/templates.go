@@ -6,9 +6,13 @@ import (
 	"go/format"
 	"io"
 	"io/fs"
+	"path"
+	"strings"
 	"text/template"
 )
 
+const templateExt = ".tmpl"
+
 // IsExist - search template in embedded file system
 func IsExist(fileSystem fs.FS, name, tplType string) bool {
 	f, err := fileSystem.Open(fmt.Sprintf("templates/%s/%s.tmpl", tplType, name))
@@ -20,6 +24,34 @@ func IsExist(fileSystem fs.FS, name, tplType string) bool {
 	return true
 }
 
+// IsSet - check that template with given name is a directory of templates, one for each generated file
+func IsSet(fileSystem fs.FS, name, tplType string) bool {
+	info, err := fs.Stat(fileSystem, fmt.Sprintf("templates/%s/%s", tplType, name))
+	if err != nil {
+		return false
+	}
+
+	return info.IsDir()
+}
+
+// ListSet - get names of generated files of the template set, in the lexical order
+func ListSet(fileSystem fs.FS, name, tplType string) ([]string, error) {
+	entries, err := fs.ReadDir(fileSystem, fmt.Sprintf("templates/%s/%s", tplType, name))
+	if err != nil {
+		return nil, fmt.Errorf("read template set with name (%s) type (%s): %w", name, tplType, err)
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != templateExt {
+			continue
+		}
+		files = append(files, strings.TrimSuffix(entry.Name(), templateExt))
+	}
+
+	return files, nil
+}
+
 // Generate - execute template with given name and vars
 func Generate(fileSystem fs.FS, tplType, name string, vars any) (io.Reader, error) {
 	var (
@@ -0,0 +1,102 @@
+package iambatch
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common/mutexkv"
+)
+
+// UpdateFunc applies access binding deltas to a resource with a single UpdateAccessBindings call.
+type UpdateFunc func(ctx context.Context, deltas []*access.AccessBindingDelta) error
+
+// Batcher coalesces access binding deltas of concurrent callers. Deltas for the same key
+// which arrive while the key is locked by another update are applied together once
+// the lock is released, and the result is returned to each of the callers.
+type Batcher struct {
+	lock    sync.Mutex
+	mutexKV *mutexkv.MutexKV
+	pending map[string]*batch
+}
+
+type batch struct {
+	deltas  []*access.AccessBindingDelta
+	callers int
+	done    chan struct{}
+	err     error
+}
+
+// Returns a properly initialized Batcher, the updates are serialized with the
+// other changes locking the same keys of the MutexKV
+func NewBatcher(mutexKV *mutexkv.MutexKV) *Batcher {
+	return &Batcher{
+		mutexKV: mutexKV,
+		pending: make(map[string]*batch),
+	}
+}
+
+// Update applies deltas to the resource identified by the key. The update function of the
+// caller which started the batch is used for all the deltas of the batch, so the key
+// must identify the resource unambiguously.
+func (b *Batcher) Update(ctx context.Context, key string, deltas []*access.AccessBindingDelta, update UpdateFunc) error {
+	b.lock.Lock()
+	if pending, ok := b.pending[key]; ok {
+		pending.deltas = append(pending.deltas, deltas...)
+		pending.callers++
+		b.lock.Unlock()
+
+		<-pending.done
+		return pending.err
+	}
+
+	current := &batch{
+		deltas:  append([]*access.AccessBindingDelta(nil), deltas...),
+		callers: 1,
+		done:    make(chan struct{}),
+	}
+	b.pending[key] = current
+	b.lock.Unlock()
+
+	b.mutexKV.Lock(key)
+	defer b.mutexKV.Unlock(key)
+
+	// Close the batch, the callers coming from now on start the next one.
+	b.lock.Lock()
+	delete(b.pending, key)
+	b.lock.Unlock()
+
+	merged := MergeDeltas(current.deltas)
+	log.Printf("[DEBUG] Applying %d access binding deltas of %d callers for %q", len(merged), current.callers, key)
+
+	current.err = update(ctx, merged)
+	close(current.done)
+
+	return current.err
+}
+
+// MergeDeltas removes duplicate deltas of the same access binding. The last action of
+// the binding wins, as if the deltas were applied one after another.
+func MergeDeltas(deltas []*access.AccessBindingDelta) []*access.AccessBindingDelta {
+	positions := make(map[string]int, len(deltas))
+	var result []*access.AccessBindingDelta
+
+	for _, d := range deltas {
+		key := deltaKey(d)
+		if i, ok := positions[key]; ok {
+			result[i] = d
+			continue
+		}
+		positions[key] = len(result)
+		result = append(result, d)
+	}
+
+	return result
+}
+
+func deltaKey(d *access.AccessBindingDelta) string {
+	b := d.GetAccessBinding()
+	return b.GetRoleId() + "\x00" + b.GetSubject().GetType() + "\x00" + b.GetSubject().GetId()
+}
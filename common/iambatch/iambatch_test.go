@@ -0,0 +1,129 @@
+package iambatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common/mutexkv"
+)
+
+func testDelta(action access.AccessBindingAction, role, id string) *access.AccessBindingDelta {
+	return &access.AccessBindingDelta{
+		Action: action,
+		AccessBinding: &access.AccessBinding{
+			RoleId:  role,
+			Subject: &access.Subject{Type: "userAccount", Id: id},
+		},
+	}
+}
+
+func TestBatcherUpdateCoalesces(t *testing.T) {
+	mkv := mutexkv.NewMutexKV()
+	b := NewBatcher(mkv)
+
+	var calls int32
+	var applied int32
+	update := func(_ context.Context, deltas []*access.AccessBindingDelta) error {
+		atomic.AddInt32(&calls, 1)
+		atomic.AddInt32(&applied, int32(len(deltas)))
+		return nil
+	}
+
+	// Hold the key so that all the callers join the same pending batch.
+	mkv.Lock("folder")
+
+	const callers = 50
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			err := b.Update(context.Background(), "folder", []*access.AccessBindingDelta{
+				testDelta(access.AccessBindingAction_ADD, "viewer", fmt.Sprintf("user%d", i)),
+			}, update)
+			assert.NoError(t, err)
+		}(i)
+	}
+
+	require.Eventually(t, func() bool {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		return b.pending["folder"] != nil && b.pending["folder"].callers == callers
+	}, 5*time.Second, time.Millisecond)
+
+	mkv.Unlock("folder")
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls)
+	assert.Equal(t, int32(callers), applied)
+}
+
+func TestBatcherUpdateFansOutError(t *testing.T) {
+	mkv := mutexkv.NewMutexKV()
+	b := NewBatcher(mkv)
+	updateErr := errors.New("permission denied")
+
+	mkv.Lock("folder")
+
+	errs := make(chan error, 2)
+	for _, id := range []string{"user1", "user2"} {
+		go func(id string) {
+			errs <- b.Update(context.Background(), "folder", []*access.AccessBindingDelta{
+				testDelta(access.AccessBindingAction_ADD, "viewer", id),
+			}, func(context.Context, []*access.AccessBindingDelta) error {
+				return updateErr
+			})
+		}(id)
+	}
+
+	require.Eventually(t, func() bool {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		return b.pending["folder"] != nil && b.pending["folder"].callers == 2
+	}, 5*time.Second, time.Millisecond)
+	mkv.Unlock("folder")
+
+	assert.ErrorIs(t, <-errs, updateErr)
+	assert.ErrorIs(t, <-errs, updateErr)
+}
+
+func TestBatcherUpdateSeparateKeys(t *testing.T) {
+	b := NewBatcher(mutexkv.NewMutexKV())
+
+	var keys []string
+	for _, key := range []string{"folder-1", "folder-2"} {
+		err := b.Update(context.Background(), key, []*access.AccessBindingDelta{
+			testDelta(access.AccessBindingAction_ADD, "viewer", "user1"),
+		}, func(_ context.Context, deltas []*access.AccessBindingDelta) error {
+			keys = append(keys, key)
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"folder-1", "folder-2"}, keys)
+}
+
+func TestMergeDeltas(t *testing.T) {
+	merged := MergeDeltas([]*access.AccessBindingDelta{
+		testDelta(access.AccessBindingAction_ADD, "viewer", "user1"),
+		testDelta(access.AccessBindingAction_ADD, "editor", "user1"),
+		testDelta(access.AccessBindingAction_ADD, "viewer", "user1"),
+		testDelta(access.AccessBindingAction_REMOVE, "editor", "user1"),
+	})
+
+	require.Len(t, merged, 2)
+	assert.Equal(t, "viewer", merged[0].AccessBinding.RoleId)
+	assert.Equal(t, access.AccessBindingAction_ADD, merged[0].Action)
+	assert.Equal(t, "editor", merged[1].AccessBinding.RoleId)
+	assert.Equal(t, access.AccessBindingAction_REMOVE, merged[1].Action)
+}
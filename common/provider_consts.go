@@ -6,6 +6,7 @@ const (
 	DefaultStorageEndpoint = "storage.yandexcloud.net"
 	DefaultYMQEndpoint     = "message-queue.api.cloud.yandex.net"
 	DefaultRegion          = "ru-central1"
+	DefaultWIFEndpoint     = "https://auth.yandex.cloud/oauth/token"
 )
 
 var Descriptions = map[string]string{
@@ -49,7 +50,35 @@ var Descriptions = map[string]string{
 	"ymq_secret_key": "Yandex.Cloud Message Queue service secret key. \n" +
 		"Used when a message queue resource doesn't have a secret key explicitly specified.",
 
-	"shared_credentials_file": "Path to shared credentials file.",
-
-	"profile": "Profile to use in the shared credentials file. Default value is `default`.",
+	"shared_credentials_file": "Path to shared credentials file. Its profile settings are used when not specified \n" +
+		"in the provider configuration or the environment variables. The yc CLI `config.yaml` is accepted too.",
+
+	"profile": "Profile to use in the shared credentials file. Default value is the current profile of the yc CLI configuration file or `default`.",
+
+	"default_labels": "Labels added to every resource supporting them. \n" +
+		"Labels set on a resource take precedence over the default ones with the same key.",
+
+	"api_trace_file": "Path to the file to append the API calls to as JSON lines, for debugging. \n" +
+		"Sensitive values are redacted. This can also be specified using environment variable `YC_API_TRACE_FILE`.",
+
+	"impersonate_service_account_id": "ID of the service account to act as. The IAM token of the service account is issued \n" +
+		"on behalf of the configured credentials, which need the `iam.serviceAccounts.tokenCreator` role on it. \n" +
+		"This can also be specified using environment variable `YC_IMPERSONATE_SERVICE_ACCOUNT_ID`.",
+	"impersonate_service_account_delegates": "IDs of the service accounts to impersonate in turn before `impersonate_service_account_id`. \n" +
+		"Each account in the chain needs the `iam.serviceAccounts.tokenCreator` role on the next one.",
+
+	"workload_identity_federation": "Workload identity federation credentials: a JWT issued by an external OIDC provider, \n" +
+		"e.g. a CI system, is exchanged for an IAM token of the federated service account. \n" +
+		"Takes precedence over `token` and `service_account_key_file`.",
+	"workload_identity_federation_token_file":         "Path to the file with the JWT. The file is read again on every token refresh.",
+	"workload_identity_federation_service_account_id": "ID of the service account to get the IAM token of.",
+	"workload_identity_federation_endpoint":           "Token exchange endpoint. Default is \n" + DefaultWIFEndpoint,
+
+	"rate_limit": "Client-side limit of the API requests to a service. \n" +
+		"Requests to the services without own limit are limited by the limit without `service`, if any.",
+	"rate_limit_service": "Name of the service to limit, as in the API package `yandex.cloud.<service>`, e.g. `iam` or `dns`. \n" +
+		"Omit to limit all the services without own limit.",
+	"rate_limit_requests_per_second":     "Maximum average number of the API requests per second.",
+	"rate_limit_burst":                   "Maximum number of the API requests sent at once. Default is `requests_per_second` rounded up.",
+	"rate_limit_max_concurrent_requests": "Maximum number of the API requests in flight.",
 }
@@ -0,0 +1,99 @@
+package fakecloud
+
+import (
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// listAccessBindings returns access bindings of the resource. Must be called with s.mu held.
+func (s *Server) listAccessBindings(resourceID string) *access.ListAccessBindingsResponse {
+	resp := &access.ListAccessBindingsResponse{}
+	for _, b := range s.accessBindings[resourceID] {
+		resp.AccessBindings = append(resp.AccessBindings, proto.Clone(b).(*access.AccessBinding))
+	}
+	return resp
+}
+
+// setAccessBindings replaces access bindings of the resource. Must be called with s.mu held.
+func (s *Server) setAccessBindings(req *access.SetAccessBindingsRequest) (*operation.Operation, error) {
+	bindings := make([]*access.AccessBinding, 0, len(req.GetAccessBindings()))
+	for _, b := range req.GetAccessBindings() {
+		if err := validateAccessBinding(b); err != nil {
+			return nil, err
+		}
+		if indexOfAccessBinding(bindings, b) < 0 {
+			bindings = append(bindings, proto.Clone(b).(*access.AccessBinding))
+		}
+	}
+	s.accessBindings[req.GetResourceId()] = bindings
+
+	return s.newOperation(
+		describe("Set access bindings of", "resource", req.GetResourceId()),
+		&access.SetAccessBindingsMetadata{ResourceId: req.GetResourceId()},
+		nil,
+	)
+}
+
+// updateAccessBindings applies access binding deltas to the resource. Must be called with s.mu held.
+func (s *Server) updateAccessBindings(req *access.UpdateAccessBindingsRequest) (*operation.Operation, error) {
+	bindings := s.accessBindings[req.GetResourceId()]
+	result := &access.AccessBindingsOperationResult{}
+
+	for _, delta := range req.GetAccessBindingDeltas() {
+		b := delta.GetAccessBinding()
+		if err := validateAccessBinding(b); err != nil {
+			return nil, err
+		}
+
+		i := indexOfAccessBinding(bindings, b)
+		switch delta.GetAction() {
+		case access.AccessBindingAction_ADD:
+			if i >= 0 {
+				continue
+			}
+			bindings = append(bindings, proto.Clone(b).(*access.AccessBinding))
+		case access.AccessBindingAction_REMOVE:
+			if i < 0 {
+				continue
+			}
+			bindings = append(bindings[:i:i], bindings[i+1:]...)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported access binding action %s", delta.GetAction())
+		}
+		result.EffectiveDeltas = append(result.EffectiveDeltas, proto.Clone(delta).(*access.AccessBindingDelta))
+	}
+	s.accessBindings[req.GetResourceId()] = bindings
+
+	return s.newOperation(
+		describe("Update access bindings of", "resource", req.GetResourceId()),
+		&access.UpdateAccessBindingsMetadata{ResourceId: req.GetResourceId()},
+		result,
+	)
+}
+
+func validateAccessBinding(b *access.AccessBinding) error {
+	if b.GetRoleId() == "" {
+		return required("role_id")
+	}
+	if b.GetSubject().GetId() == "" {
+		return required("subject.id")
+	}
+	if b.GetSubject().GetType() == "" {
+		return required("subject.type")
+	}
+	return nil
+}
+
+func indexOfAccessBinding(bindings []*access.AccessBinding, b *access.AccessBinding) int {
+	for i, existing := range bindings {
+		if existing.GetRoleId() == b.GetRoleId() &&
+			existing.GetSubject().GetId() == b.GetSubject().GetId() &&
+			existing.GetSubject().GetType() == b.GetSubject().GetType() {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,187 @@
+package fakecloud
+
+import (
+	"context"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultDiskType      = "network-hdd"
+	defaultDiskBlockSize = 4096
+)
+
+type diskService struct {
+	compute.UnimplementedDiskServiceServer
+	s *Server
+}
+
+func (d *diskService) Get(_ context.Context, req *compute.GetDiskRequest) (*compute.Disk, error) {
+	d.s.mu.Lock()
+	defer d.s.mu.Unlock()
+
+	disk, ok := d.s.disks[req.GetDiskId()]
+	if !ok {
+		return nil, notFound("Disk", req.GetDiskId())
+	}
+	return proto.Clone(disk).(*compute.Disk), nil
+}
+
+func (d *diskService) List(_ context.Context, req *compute.ListDisksRequest) (*compute.ListDisksResponse, error) {
+	d.s.mu.Lock()
+	defer d.s.mu.Unlock()
+
+	if req.GetFolderId() == "" {
+		return nil, required("folder_id")
+	}
+
+	resp := &compute.ListDisksResponse{}
+	for _, disk := range d.s.disks {
+		if disk.FolderId != req.GetFolderId() {
+			continue
+		}
+		ok, err := matchesFilter(req.GetFilter(), disk.Name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			resp.Disks = append(resp.Disks, proto.Clone(disk).(*compute.Disk))
+		}
+	}
+	return resp, nil
+}
+
+func (d *diskService) Create(_ context.Context, req *compute.CreateDiskRequest) (*operation.Operation, error) {
+	d.s.mu.Lock()
+	defer d.s.mu.Unlock()
+
+	if err := d.s.checkFolder(req.GetFolderId()); err != nil {
+		return nil, err
+	}
+	if req.GetZoneId() == "" {
+		return nil, required("zone_id")
+	}
+	if req.GetSize() <= 0 {
+		return nil, required("size")
+	}
+
+	disk := &compute.Disk{
+		Id:                  d.s.newID("fhm"),
+		FolderId:            req.GetFolderId(),
+		CreatedAt:           now(),
+		Name:                req.GetName(),
+		Description:         req.GetDescription(),
+		Labels:              req.GetLabels(),
+		TypeId:              req.GetTypeId(),
+		ZoneId:              req.GetZoneId(),
+		Size:                req.GetSize(),
+		BlockSize:           req.GetBlockSize(),
+		Status:              compute.Disk_READY,
+		DiskPlacementPolicy: req.GetDiskPlacementPolicy(),
+	}
+	if disk.TypeId == "" {
+		disk.TypeId = defaultDiskType
+	}
+	if disk.BlockSize == 0 {
+		disk.BlockSize = defaultDiskBlockSize
+	}
+	switch source := req.GetSource().(type) {
+	case *compute.CreateDiskRequest_ImageId:
+		disk.Source = &compute.Disk_SourceImageId{SourceImageId: source.ImageId}
+	case *compute.CreateDiskRequest_SnapshotId:
+		disk.Source = &compute.Disk_SourceSnapshotId{SourceSnapshotId: source.SnapshotId}
+	}
+	d.s.disks[disk.Id] = disk
+
+	return d.s.newOperation(describe("Create", "disk", disk.Id),
+		&compute.CreateDiskMetadata{DiskId: disk.Id}, disk)
+}
+
+func (d *diskService) Update(_ context.Context, req *compute.UpdateDiskRequest) (*operation.Operation, error) {
+	d.s.mu.Lock()
+	defer d.s.mu.Unlock()
+
+	disk, ok := d.s.disks[req.GetDiskId()]
+	if !ok {
+		return nil, notFound("Disk", req.GetDiskId())
+	}
+
+	updated := proto.Clone(disk).(*compute.Disk)
+	paths := updatePaths(req.GetUpdateMask(), "name", "description", "labels", "size", "disk_placement_policy")
+	for _, path := range paths {
+		switch path {
+		case "name":
+			updated.Name = req.GetName()
+		case "description":
+			updated.Description = req.GetDescription()
+		case "labels":
+			updated.Labels = req.GetLabels()
+		case "size":
+			if req.GetSize() < disk.Size {
+				return nil, status.Errorf(codes.InvalidArgument, "Disk %s can not be shrunk", disk.Id)
+			}
+			updated.Size = req.GetSize()
+		case "disk_placement_policy":
+			updated.DiskPlacementPolicy = req.GetDiskPlacementPolicy()
+		default:
+			return nil, unknownField(path)
+		}
+	}
+	d.s.disks[updated.Id] = updated
+
+	return d.s.newOperation(describe("Update", "disk", updated.Id),
+		&compute.UpdateDiskMetadata{DiskId: updated.Id}, updated)
+}
+
+func (d *diskService) Delete(_ context.Context, req *compute.DeleteDiskRequest) (*operation.Operation, error) {
+	d.s.mu.Lock()
+	defer d.s.mu.Unlock()
+
+	disk, ok := d.s.disks[req.GetDiskId()]
+	if !ok {
+		return nil, notFound("Disk", req.GetDiskId())
+	}
+	if len(disk.InstanceIds) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Disk %s is attached to instance", disk.Id)
+	}
+	delete(d.s.disks, req.GetDiskId())
+	delete(d.s.accessBindings, req.GetDiskId())
+
+	return d.s.newOperation(describe("Delete", "disk", req.GetDiskId()),
+		&compute.DeleteDiskMetadata{DiskId: req.GetDiskId()}, nil)
+}
+
+func (d *diskService) ListAccessBindings(_ context.Context, req *access.ListAccessBindingsRequest) (*access.ListAccessBindingsResponse, error) {
+	d.s.mu.Lock()
+	defer d.s.mu.Unlock()
+
+	if _, ok := d.s.disks[req.GetResourceId()]; !ok {
+		return nil, notFound("Disk", req.GetResourceId())
+	}
+	return d.s.listAccessBindings(req.GetResourceId()), nil
+}
+
+func (d *diskService) SetAccessBindings(_ context.Context, req *access.SetAccessBindingsRequest) (*operation.Operation, error) {
+	d.s.mu.Lock()
+	defer d.s.mu.Unlock()
+
+	if _, ok := d.s.disks[req.GetResourceId()]; !ok {
+		return nil, notFound("Disk", req.GetResourceId())
+	}
+	return d.s.setAccessBindings(req)
+}
+
+func (d *diskService) UpdateAccessBindings(_ context.Context, req *access.UpdateAccessBindingsRequest) (*operation.Operation, error) {
+	d.s.mu.Lock()
+	defer d.s.mu.Unlock()
+
+	if _, ok := d.s.disks[req.GetResourceId()]; !ok {
+		return nil, notFound("Disk", req.GetResourceId())
+	}
+	return d.s.updateAccessBindings(req)
+}
@@ -0,0 +1,183 @@
+package fakecloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const tokenLifetime = 12 * time.Hour
+
+type iamTokenService struct {
+	iam.UnimplementedIamTokenServiceServer
+}
+
+// Create exchanges any OAuth token or service account JWT for the fake IAM token.
+func (*iamTokenService) Create(context.Context, *iam.CreateIamTokenRequest) (*iam.CreateIamTokenResponse, error) {
+	return &iam.CreateIamTokenResponse{
+		IamToken:  Token,
+		ExpiresAt: timestamppb.New(time.Now().Add(tokenLifetime)),
+	}, nil
+}
+
+func (*iamTokenService) CreateForServiceAccount(context.Context, *iam.CreateIamTokenForServiceAccountRequest) (*iam.CreateIamTokenResponse, error) {
+	return &iam.CreateIamTokenResponse{
+		IamToken:  Token,
+		ExpiresAt: timestamppb.New(time.Now().Add(tokenLifetime)),
+	}, nil
+}
+
+type userAccountService struct {
+	iam.UnimplementedYandexPassportUserAccountServiceServer
+	s *Server
+}
+
+func (u *userAccountService) GetByLogin(_ context.Context, req *iam.GetUserAccountByLoginRequest) (*iam.UserAccount, error) {
+	u.s.mu.Lock()
+	defer u.s.mu.Unlock()
+
+	account, ok := u.s.userAccounts[req.GetLogin()]
+	if !ok {
+		return nil, notFound("User account with login", req.GetLogin())
+	}
+	return proto.Clone(account).(*iam.UserAccount), nil
+}
+
+type serviceAccountService struct {
+	iam.UnimplementedServiceAccountServiceServer
+	s *Server
+}
+
+func (sa *serviceAccountService) Get(_ context.Context, req *iam.GetServiceAccountRequest) (*iam.ServiceAccount, error) {
+	sa.s.mu.Lock()
+	defer sa.s.mu.Unlock()
+
+	account, ok := sa.s.serviceAccounts[req.GetServiceAccountId()]
+	if !ok {
+		return nil, notFound("Service account", req.GetServiceAccountId())
+	}
+	return proto.Clone(account).(*iam.ServiceAccount), nil
+}
+
+func (sa *serviceAccountService) List(_ context.Context, req *iam.ListServiceAccountsRequest) (*iam.ListServiceAccountsResponse, error) {
+	sa.s.mu.Lock()
+	defer sa.s.mu.Unlock()
+
+	if req.GetFolderId() == "" {
+		return nil, required("folder_id")
+	}
+
+	resp := &iam.ListServiceAccountsResponse{}
+	for _, account := range sa.s.serviceAccounts {
+		if account.FolderId != req.GetFolderId() {
+			continue
+		}
+		ok, err := matchesFilter(req.GetFilter(), account.Name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			resp.ServiceAccounts = append(resp.ServiceAccounts, proto.Clone(account).(*iam.ServiceAccount))
+		}
+	}
+	return resp, nil
+}
+
+func (sa *serviceAccountService) Create(_ context.Context, req *iam.CreateServiceAccountRequest) (*operation.Operation, error) {
+	sa.s.mu.Lock()
+	defer sa.s.mu.Unlock()
+
+	if err := sa.s.checkFolder(req.GetFolderId()); err != nil {
+		return nil, err
+	}
+
+	account := &iam.ServiceAccount{
+		Id:          sa.s.newID("aje"),
+		FolderId:    req.GetFolderId(),
+		CreatedAt:   now(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Labels:      req.GetLabels(),
+	}
+	sa.s.serviceAccounts[account.Id] = account
+
+	return sa.s.newOperation(describe("Create", "service account", account.Id),
+		&iam.CreateServiceAccountMetadata{ServiceAccountId: account.Id}, account)
+}
+
+func (sa *serviceAccountService) Update(_ context.Context, req *iam.UpdateServiceAccountRequest) (*operation.Operation, error) {
+	sa.s.mu.Lock()
+	defer sa.s.mu.Unlock()
+
+	account, ok := sa.s.serviceAccounts[req.GetServiceAccountId()]
+	if !ok {
+		return nil, notFound("Service account", req.GetServiceAccountId())
+	}
+
+	updated := proto.Clone(account).(*iam.ServiceAccount)
+	for _, path := range updatePaths(req.GetUpdateMask(), "name", "description", "labels") {
+		switch path {
+		case "name":
+			updated.Name = req.GetName()
+		case "description":
+			updated.Description = req.GetDescription()
+		case "labels":
+			updated.Labels = req.GetLabels()
+		default:
+			return nil, unknownField(path)
+		}
+	}
+	sa.s.serviceAccounts[updated.Id] = updated
+
+	return sa.s.newOperation(describe("Update", "service account", updated.Id),
+		&iam.UpdateServiceAccountMetadata{ServiceAccountId: updated.Id}, updated)
+}
+
+func (sa *serviceAccountService) Delete(_ context.Context, req *iam.DeleteServiceAccountRequest) (*operation.Operation, error) {
+	sa.s.mu.Lock()
+	defer sa.s.mu.Unlock()
+
+	if _, ok := sa.s.serviceAccounts[req.GetServiceAccountId()]; !ok {
+		return nil, notFound("Service account", req.GetServiceAccountId())
+	}
+	delete(sa.s.serviceAccounts, req.GetServiceAccountId())
+	delete(sa.s.accessBindings, req.GetServiceAccountId())
+
+	return sa.s.newOperation(describe("Delete", "service account", req.GetServiceAccountId()),
+		&iam.DeleteServiceAccountMetadata{ServiceAccountId: req.GetServiceAccountId()}, nil)
+}
+
+func (sa *serviceAccountService) ListAccessBindings(_ context.Context, req *access.ListAccessBindingsRequest) (*access.ListAccessBindingsResponse, error) {
+	sa.s.mu.Lock()
+	defer sa.s.mu.Unlock()
+
+	if _, ok := sa.s.serviceAccounts[req.GetResourceId()]; !ok {
+		return nil, notFound("Service account", req.GetResourceId())
+	}
+	return sa.s.listAccessBindings(req.GetResourceId()), nil
+}
+
+func (sa *serviceAccountService) SetAccessBindings(_ context.Context, req *access.SetAccessBindingsRequest) (*operation.Operation, error) {
+	sa.s.mu.Lock()
+	defer sa.s.mu.Unlock()
+
+	if _, ok := sa.s.serviceAccounts[req.GetResourceId()]; !ok {
+		return nil, notFound("Service account", req.GetResourceId())
+	}
+	return sa.s.setAccessBindings(req)
+}
+
+func (sa *serviceAccountService) UpdateAccessBindings(_ context.Context, req *access.UpdateAccessBindingsRequest) (*operation.Operation, error) {
+	sa.s.mu.Lock()
+	defer sa.s.mu.Unlock()
+
+	if _, ok := sa.s.serviceAccounts[req.GetResourceId()]; !ok {
+		return nil, notFound("Service account", req.GetResourceId())
+	}
+	return sa.s.updateAccessBindings(req)
+}
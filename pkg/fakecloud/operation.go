@@ -0,0 +1,161 @@
+package fakecloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/endpoint"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// serviceIDs are the identifiers of the services implemented by the fake, as known by the SDK.
+var serviceIDs = []string{
+	"endpoint",
+	"operation",
+	"resource-manager",
+	"iam",
+	"vpc",
+	"compute",
+}
+
+type apiEndpointService struct {
+	endpoint.UnimplementedApiEndpointServiceServer
+	s *Server
+}
+
+func (e *apiEndpointService) Get(_ context.Context, req *endpoint.GetApiEndpointRequest) (*endpoint.ApiEndpoint, error) {
+	for _, id := range serviceIDs {
+		if id == req.GetApiEndpointId() {
+			return &endpoint.ApiEndpoint{Id: id, Address: e.s.Endpoint()}, nil
+		}
+	}
+	return nil, notFound("API endpoint", req.GetApiEndpointId())
+}
+
+func (e *apiEndpointService) List(context.Context, *endpoint.ListApiEndpointsRequest) (*endpoint.ListApiEndpointsResponse, error) {
+	resp := &endpoint.ListApiEndpointsResponse{}
+	for _, id := range serviceIDs {
+		resp.Endpoints = append(resp.Endpoints, &endpoint.ApiEndpoint{Id: id, Address: e.s.Endpoint()})
+	}
+	return resp, nil
+}
+
+type operationService struct {
+	operation.UnimplementedOperationServiceServer
+	s *Server
+}
+
+func (o *operationService) Get(_ context.Context, req *operation.GetOperationRequest) (*operation.Operation, error) {
+	o.s.mu.Lock()
+	defer o.s.mu.Unlock()
+
+	op, ok := o.s.operations[req.GetOperationId()]
+	if !ok {
+		return nil, notFound("Operation", req.GetOperationId())
+	}
+	return proto.Clone(op).(*operation.Operation), nil
+}
+
+func (o *operationService) Cancel(_ context.Context, req *operation.CancelOperationRequest) (*operation.Operation, error) {
+	o.s.mu.Lock()
+	defer o.s.mu.Unlock()
+
+	op, ok := o.s.operations[req.GetOperationId()]
+	if !ok {
+		return nil, notFound("Operation", req.GetOperationId())
+	}
+	return nil, status.Errorf(codes.FailedPrecondition, "Operation %s is already done", op.Id)
+}
+
+// newOperation registers a completed operation, or a running one if the operations are held. Must be called with s.mu held.
+// A nil response means google.protobuf.Empty, as returned by delete operations.
+func (s *Server) newOperation(description string, metadata, response proto.Message) (*operation.Operation, error) {
+	if response == nil {
+		response = &emptypb.Empty{}
+	}
+
+	md, err := anypb.New(metadata)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to pack operation metadata: %s", err)
+	}
+	resp, err := anypb.New(response)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to pack operation response: %s", err)
+	}
+
+	ts := now()
+	op := &operation.Operation{
+		Id:          s.newID("fko"),
+		Description: description,
+		CreatedAt:   ts,
+		CreatedBy:   "fake",
+		ModifiedAt:  ts,
+		Done:        true,
+		Metadata:    md,
+		Result:      &operation.Operation_Response{Response: resp},
+	}
+	if s.holdOperations {
+		s.heldOperations[op.Id] = op
+		op = &operation.Operation{
+			Id:          op.Id,
+			Description: op.Description,
+			CreatedAt:   op.CreatedAt,
+			CreatedBy:   op.CreatedBy,
+			ModifiedAt:  op.ModifiedAt,
+			Metadata:    op.Metadata,
+		}
+	}
+	s.operations[op.Id] = op
+	return proto.Clone(op).(*operation.Operation), nil
+}
+
+func now() *timestamppb.Timestamp {
+	return timestamppb.Now()
+}
+
+func notFound(kind, id string) error {
+	return status.Errorf(codes.NotFound, "%s %s not found", kind, id)
+}
+
+func required(field string) error {
+	return status.Errorf(codes.InvalidArgument, "Request validation error: %s: Field required", field)
+}
+
+func unknownField(path string) error {
+	return status.Errorf(codes.InvalidArgument, "Request validation error: unsupported update mask path %q", path)
+}
+
+func describe(action, kind, id string) string {
+	return fmt.Sprintf("%s %s %s", action, kind, id)
+}
+
+var nameFilterRegexp = regexp.MustCompile(`^\s*name\s*=\s*"([^"]*)"\s*$`)
+
+// matchesFilter reports whether a resource with the given name passes the List request filter.
+// Only the `name = "..."` filter used by the provider data sources is supported.
+func matchesFilter(filter, name string) (bool, error) {
+	if filter == "" {
+		return true, nil
+	}
+	m := nameFilterRegexp.FindStringSubmatch(filter)
+	if m == nil {
+		return false, status.Errorf(codes.InvalidArgument, "unsupported filter %q", filter)
+	}
+	return m[1] == name, nil
+}
+
+// updatePaths returns the paths of the update mask, or all the updatable fields if the mask is empty.
+func updatePaths(mask *field_mask.FieldMask, fields ...string) []string {
+	if len(mask.GetPaths()) == 0 {
+		return fields
+	}
+	return mask.GetPaths()
+}
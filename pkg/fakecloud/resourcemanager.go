@@ -0,0 +1,242 @@
+package fakecloud
+
+import (
+	"context"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type cloudService struct {
+	resourcemanager.UnimplementedCloudServiceServer
+	s *Server
+}
+
+func (c *cloudService) Get(_ context.Context, req *resourcemanager.GetCloudRequest) (*resourcemanager.Cloud, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	cloud, ok := c.s.clouds[req.GetCloudId()]
+	if !ok {
+		return nil, notFound("Cloud", req.GetCloudId())
+	}
+	return proto.Clone(cloud).(*resourcemanager.Cloud), nil
+}
+
+func (c *cloudService) List(_ context.Context, req *resourcemanager.ListCloudsRequest) (*resourcemanager.ListCloudsResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	resp := &resourcemanager.ListCloudsResponse{}
+	for _, cloud := range c.s.clouds {
+		if req.GetOrganizationId() != "" && cloud.OrganizationId != req.GetOrganizationId() {
+			continue
+		}
+		ok, err := matchesFilter(req.GetFilter(), cloud.Name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			resp.Clouds = append(resp.Clouds, proto.Clone(cloud).(*resourcemanager.Cloud))
+		}
+	}
+	return resp, nil
+}
+
+func (c *cloudService) Update(_ context.Context, req *resourcemanager.UpdateCloudRequest) (*operation.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	cloud, ok := c.s.clouds[req.GetCloudId()]
+	if !ok {
+		return nil, notFound("Cloud", req.GetCloudId())
+	}
+
+	updated := proto.Clone(cloud).(*resourcemanager.Cloud)
+	for _, path := range updatePaths(req.GetUpdateMask(), "name", "description", "labels") {
+		switch path {
+		case "name":
+			updated.Name = req.GetName()
+		case "description":
+			updated.Description = req.GetDescription()
+		case "labels":
+			updated.Labels = req.GetLabels()
+		default:
+			return nil, unknownField(path)
+		}
+	}
+	c.s.clouds[updated.Id] = updated
+
+	return c.s.newOperation(describe("Update", "cloud", updated.Id),
+		&resourcemanager.UpdateCloudMetadata{CloudId: updated.Id}, updated)
+}
+
+func (c *cloudService) ListAccessBindings(_ context.Context, req *access.ListAccessBindingsRequest) (*access.ListAccessBindingsResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	if _, ok := c.s.clouds[req.GetResourceId()]; !ok {
+		return nil, notFound("Cloud", req.GetResourceId())
+	}
+	return c.s.listAccessBindings(req.GetResourceId()), nil
+}
+
+func (c *cloudService) SetAccessBindings(_ context.Context, req *access.SetAccessBindingsRequest) (*operation.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	if _, ok := c.s.clouds[req.GetResourceId()]; !ok {
+		return nil, notFound("Cloud", req.GetResourceId())
+	}
+	return c.s.setAccessBindings(req)
+}
+
+func (c *cloudService) UpdateAccessBindings(_ context.Context, req *access.UpdateAccessBindingsRequest) (*operation.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	if _, ok := c.s.clouds[req.GetResourceId()]; !ok {
+		return nil, notFound("Cloud", req.GetResourceId())
+	}
+	return c.s.updateAccessBindings(req)
+}
+
+type folderService struct {
+	resourcemanager.UnimplementedFolderServiceServer
+	s *Server
+}
+
+func (f *folderService) Get(_ context.Context, req *resourcemanager.GetFolderRequest) (*resourcemanager.Folder, error) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+
+	folder, ok := f.s.folders[req.GetFolderId()]
+	if !ok {
+		return nil, notFound("Folder", req.GetFolderId())
+	}
+	return proto.Clone(folder).(*resourcemanager.Folder), nil
+}
+
+func (f *folderService) List(_ context.Context, req *resourcemanager.ListFoldersRequest) (*resourcemanager.ListFoldersResponse, error) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+
+	if req.GetCloudId() == "" {
+		return nil, required("cloud_id")
+	}
+
+	resp := &resourcemanager.ListFoldersResponse{}
+	for _, folder := range f.s.folders {
+		if folder.CloudId != req.GetCloudId() {
+			continue
+		}
+		ok, err := matchesFilter(req.GetFilter(), folder.Name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			resp.Folders = append(resp.Folders, proto.Clone(folder).(*resourcemanager.Folder))
+		}
+	}
+	return resp, nil
+}
+
+func (f *folderService) Create(_ context.Context, req *resourcemanager.CreateFolderRequest) (*operation.Operation, error) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+
+	if req.GetCloudId() == "" {
+		return nil, required("cloud_id")
+	}
+	if _, ok := f.s.clouds[req.GetCloudId()]; !ok {
+		return nil, notFound("Cloud", req.GetCloudId())
+	}
+
+	folder := &resourcemanager.Folder{
+		Id:          f.s.newID("b1g"),
+		CloudId:     req.GetCloudId(),
+		CreatedAt:   now(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Labels:      req.GetLabels(),
+		Status:      resourcemanager.Folder_ACTIVE,
+	}
+	f.s.folders[folder.Id] = folder
+
+	return f.s.newOperation(describe("Create", "folder", folder.Id),
+		&resourcemanager.CreateFolderMetadata{FolderId: folder.Id}, folder)
+}
+
+func (f *folderService) Update(_ context.Context, req *resourcemanager.UpdateFolderRequest) (*operation.Operation, error) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+
+	folder, ok := f.s.folders[req.GetFolderId()]
+	if !ok {
+		return nil, notFound("Folder", req.GetFolderId())
+	}
+
+	updated := proto.Clone(folder).(*resourcemanager.Folder)
+	for _, path := range updatePaths(req.GetUpdateMask(), "name", "description", "labels") {
+		switch path {
+		case "name":
+			updated.Name = req.GetName()
+		case "description":
+			updated.Description = req.GetDescription()
+		case "labels":
+			updated.Labels = req.GetLabels()
+		default:
+			return nil, unknownField(path)
+		}
+	}
+	f.s.folders[updated.Id] = updated
+
+	return f.s.newOperation(describe("Update", "folder", updated.Id),
+		&resourcemanager.UpdateFolderMetadata{FolderId: updated.Id}, updated)
+}
+
+func (f *folderService) Delete(_ context.Context, req *resourcemanager.DeleteFolderRequest) (*operation.Operation, error) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+
+	if _, ok := f.s.folders[req.GetFolderId()]; !ok {
+		return nil, notFound("Folder", req.GetFolderId())
+	}
+	delete(f.s.folders, req.GetFolderId())
+	delete(f.s.accessBindings, req.GetFolderId())
+
+	return f.s.newOperation(describe("Delete", "folder", req.GetFolderId()),
+		&resourcemanager.DeleteFolderMetadata{FolderId: req.GetFolderId()}, nil)
+}
+
+func (f *folderService) ListAccessBindings(_ context.Context, req *access.ListAccessBindingsRequest) (*access.ListAccessBindingsResponse, error) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+
+	if _, ok := f.s.folders[req.GetResourceId()]; !ok {
+		return nil, notFound("Folder", req.GetResourceId())
+	}
+	return f.s.listAccessBindings(req.GetResourceId()), nil
+}
+
+func (f *folderService) SetAccessBindings(_ context.Context, req *access.SetAccessBindingsRequest) (*operation.Operation, error) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+
+	if _, ok := f.s.folders[req.GetResourceId()]; !ok {
+		return nil, notFound("Folder", req.GetResourceId())
+	}
+	return f.s.setAccessBindings(req)
+}
+
+func (f *folderService) UpdateAccessBindings(_ context.Context, req *access.UpdateAccessBindingsRequest) (*operation.Operation, error) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+
+	if _, ok := f.s.folders[req.GetResourceId()]; !ok {
+		return nil, notFound("Folder", req.GetResourceId())
+	}
+	return f.s.updateAccessBindings(req)
+}
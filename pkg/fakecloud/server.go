@@ -0,0 +1,229 @@
+// Package fakecloud implements an in-process fake of the Yandex Cloud gRPC API.
+//
+// The fake keeps all the state in memory and completes every operation immediately,
+// unless the operations are held with HoldOperations to test waiting for them.
+// It implements the operation and API endpoint services, as well as a subset of
+// Resource Manager, IAM, VPC and Compute services, which is enough to run
+// acceptance tests of the corresponding resources without a real cloud.
+package fakecloud
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/endpoint"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"google.golang.org/grpc"
+)
+
+const (
+	// Token is an IAM token accepted by the fake server.
+	// It has the IAM token format, so the provider uses it as is without exchange.
+	Token = "t1.fake.token"
+
+	DefaultZone   = "ru-central1-a"
+	DefaultLogin1 = "fake-user-1"
+	DefaultLogin2 = "fake-user-2"
+
+	// EnvVar enables the fake server in acceptance tests, see StartIfEnabled.
+	EnvVar = "YC_FAKE_CLOUD"
+)
+
+// Server is an in-process fake Yandex Cloud API server.
+type Server struct {
+	// CloudID and FolderID identify the cloud and the folder created on server start.
+	CloudID  string
+	FolderID string
+
+	listener   net.Listener
+	grpcServer *grpc.Server
+
+	mu              sync.Mutex
+	lastID          int
+	operations      map[string]*operation.Operation
+	holdOperations  bool
+	heldOperations  map[string]*operation.Operation
+	clouds          map[string]*resourcemanager.Cloud
+	folders         map[string]*resourcemanager.Folder
+	serviceAccounts map[string]*iam.ServiceAccount
+	userAccounts    map[string]*iam.UserAccount
+	networks        map[string]*vpc.Network
+	subnets         map[string]*vpc.Subnet
+	disks           map[string]*compute.Disk
+	accessBindings  map[string][]*access.AccessBinding
+}
+
+// Start starts the fake server listening on a random local port.
+func Start() (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen: %w", err)
+	}
+
+	s := newServer(listener)
+	go func() {
+		_ = s.grpcServer.Serve(listener)
+	}()
+	return s, nil
+}
+
+// StartIfEnabled starts the fake server if EnvVar is set to true and exports
+// the variables returned by Server.Env to the process environment, so that
+// the acceptance tests and the provider under test use the fake server.
+// It returns nil server if the fake server is not enabled.
+func StartIfEnabled() (*Server, error) {
+	enabled, _ := strconv.ParseBool(os.Getenv(EnvVar))
+	if !enabled {
+		return nil, nil
+	}
+
+	s, err := Start()
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range s.Env() {
+		if err := os.Setenv(k, v); err != nil {
+			s.Stop()
+			return nil, fmt.Errorf("failed to set %s: %w", k, err)
+		}
+	}
+	return s, nil
+}
+
+func newServer(listener net.Listener) *Server {
+	s := &Server{
+		listener:        listener,
+		grpcServer:      grpc.NewServer(),
+		operations:      make(map[string]*operation.Operation),
+		heldOperations:  make(map[string]*operation.Operation),
+		clouds:          make(map[string]*resourcemanager.Cloud),
+		folders:         make(map[string]*resourcemanager.Folder),
+		serviceAccounts: make(map[string]*iam.ServiceAccount),
+		userAccounts:    make(map[string]*iam.UserAccount),
+		networks:        make(map[string]*vpc.Network),
+		subnets:         make(map[string]*vpc.Subnet),
+		disks:           make(map[string]*compute.Disk),
+		accessBindings:  make(map[string][]*access.AccessBinding),
+	}
+
+	s.seed()
+
+	endpoint.RegisterApiEndpointServiceServer(s.grpcServer, &apiEndpointService{s: s})
+	operation.RegisterOperationServiceServer(s.grpcServer, &operationService{s: s})
+
+	resourcemanager.RegisterCloudServiceServer(s.grpcServer, &cloudService{s: s})
+	resourcemanager.RegisterFolderServiceServer(s.grpcServer, &folderService{s: s})
+
+	iam.RegisterIamTokenServiceServer(s.grpcServer, &iamTokenService{})
+	iam.RegisterServiceAccountServiceServer(s.grpcServer, &serviceAccountService{s: s})
+	iam.RegisterYandexPassportUserAccountServiceServer(s.grpcServer, &userAccountService{s: s})
+
+	vpc.RegisterNetworkServiceServer(s.grpcServer, &networkService{s: s})
+	vpc.RegisterSubnetServiceServer(s.grpcServer, &subnetService{s: s})
+
+	compute.RegisterDiskServiceServer(s.grpcServer, &diskService{s: s})
+
+	return s
+}
+
+func (s *Server) seed() {
+	s.CloudID = s.newID("b1g")
+	s.clouds[s.CloudID] = &resourcemanager.Cloud{
+		Id:        s.CloudID,
+		CreatedAt: now(),
+		Name:      "fake-cloud",
+	}
+
+	s.FolderID = s.newID("b1g")
+	s.folders[s.FolderID] = &resourcemanager.Folder{
+		Id:        s.FolderID,
+		CloudId:   s.CloudID,
+		CreatedAt: now(),
+		Name:      "fake-folder",
+		Status:    resourcemanager.Folder_ACTIVE,
+	}
+
+	for _, login := range []string{DefaultLogin1, DefaultLogin2} {
+		s.userAccounts[login] = &iam.UserAccount{
+			Id: s.newID("aje"),
+			UserAccount: &iam.UserAccount_YandexPassportUserAccount{
+				YandexPassportUserAccount: &iam.YandexPassportUserAccount{
+					Login:        login,
+					DefaultEmail: login + "@yandex.ru",
+				},
+			},
+		}
+	}
+}
+
+// Endpoint returns the address to be used as the provider `endpoint` together with `plaintext = true`.
+func (s *Server) Endpoint() string {
+	return s.listener.Addr().String()
+}
+
+// Env returns environment variables pointing the provider and the acceptance tests to the server.
+func (s *Server) Env() map[string]string {
+	return map[string]string{
+		"YC_ENDPOINT":               s.Endpoint(),
+		"YC_PLAINTEXT":              strconv.FormatBool(true),
+		"YC_TOKEN":                  Token,
+		"YC_CLOUD_ID":               s.CloudID,
+		"YC_FOLDER_ID":              s.FolderID,
+		"YC_ZONE":                   DefaultZone,
+		"YC_LOGIN":                  DefaultLogin1,
+		"YC_LOGIN_2":                DefaultLogin2,
+		"YC_STORAGE_ENDPOINT_URL":   "http://" + s.Endpoint(),
+		"YC_MESSAGE_QUEUE_ENDPOINT": "http://" + s.Endpoint(),
+	}
+}
+
+// HoldOperations makes the new operations stay in progress until CompleteOperations is called.
+// The changes of the resources are applied immediately anyway.
+func (s *Server) HoldOperations() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.holdOperations = true
+}
+
+// CompleteOperations completes the held operations and stops holding the new ones.
+func (s *Server) CompleteOperations() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, op := range s.heldOperations {
+		s.operations[id] = op
+	}
+	s.holdOperations = false
+	s.heldOperations = make(map[string]*operation.Operation)
+}
+
+// Stop stops the server and closes all the connections.
+func (s *Server) Stop() {
+	s.grpcServer.Stop()
+}
+
+// newID returns a new unique identifier of the cloud resource. Must be called with s.mu held.
+func (s *Server) newID(prefix string) string {
+	s.lastID++
+	return fmt.Sprintf("%s%017d", prefix, s.lastID)
+}
+
+// checkFolder returns an error if the folder does not exist. Must be called with s.mu held.
+func (s *Server) checkFolder(folderID string) error {
+	if folderID == "" {
+		return required("folder_id")
+	}
+	if _, ok := s.folders[folderID]; !ok {
+		return notFound("Folder", folderID)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package fakecloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestSDK(t *testing.T) (*Server, *ycsdk.SDK) {
+	t.Helper()
+
+	s, err := Start()
+	require.NoError(t, err)
+	t.Cleanup(s.Stop)
+
+	sdk, err := ycsdk.Build(context.Background(), ycsdk.Config{
+		Credentials: ycsdk.NewIAMTokenCredentials(Token),
+		Endpoint:    s.Endpoint(),
+		Plaintext:   true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sdk.Shutdown(context.Background()) })
+
+	return s, sdk
+}
+
+func TestResourceManager(t *testing.T) {
+	ctx := context.Background()
+	s, sdk := newTestSDK(t)
+
+	folder, err := sdk.ResourceManager().Folder().Get(ctx, &resourcemanager.GetFolderRequest{FolderId: s.FolderID})
+	require.NoError(t, err)
+	assert.Equal(t, s.CloudID, folder.CloudId)
+
+	op, err := sdk.WrapOperation(sdk.ResourceManager().Folder().Create(ctx, &resourcemanager.CreateFolderRequest{
+		CloudId: s.CloudID,
+		Name:    "test-folder",
+	}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	resp, err := op.Response()
+	require.NoError(t, err)
+	created := resp.(*resourcemanager.Folder)
+	assert.Equal(t, "test-folder", created.Name)
+
+	list, err := sdk.ResourceManager().Folder().List(ctx, &resourcemanager.ListFoldersRequest{
+		CloudId: s.CloudID,
+		Filter:  `name = "test-folder"`,
+	})
+	require.NoError(t, err)
+	require.Len(t, list.Folders, 1)
+	assert.Equal(t, created.Id, list.Folders[0].Id)
+
+	user, err := sdk.IAM().YandexPassportUserAccount().GetByLogin(ctx, &iam.GetUserAccountByLoginRequest{Login: DefaultLogin1})
+	require.NoError(t, err)
+	assert.NotEmpty(t, user.Id)
+}
+
+func TestVPC(t *testing.T) {
+	ctx := context.Background()
+	s, sdk := newTestSDK(t)
+
+	op, err := sdk.WrapOperation(sdk.VPC().Network().Create(ctx, &vpc.CreateNetworkRequest{
+		FolderId: s.FolderID,
+		Name:     "test-network",
+		Labels:   map[string]string{"key": "value"},
+	}))
+	require.NoError(t, err)
+	md, err := op.Metadata()
+	require.NoError(t, err)
+	networkID := md.(*vpc.CreateNetworkMetadata).NetworkId
+
+	op, err = sdk.WrapOperation(sdk.VPC().Subnet().Create(ctx, &vpc.CreateSubnetRequest{
+		FolderId:     s.FolderID,
+		Name:         "test-subnet",
+		NetworkId:    networkID,
+		ZoneId:       DefaultZone,
+		V4CidrBlocks: []string{"10.0.0.0/24"},
+	}))
+	require.NoError(t, err)
+	md, err = op.Metadata()
+	require.NoError(t, err)
+	subnetID := md.(*vpc.CreateSubnetMetadata).SubnetId
+
+	_, err = sdk.VPC().Network().Delete(ctx, &vpc.DeleteNetworkRequest{NetworkId: networkID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = sdk.VPC().Network().Update(ctx, &vpc.UpdateNetworkRequest{
+		NetworkId:   networkID,
+		UpdateMask:  &field_mask.FieldMask{Paths: []string{"description"}},
+		Name:        "ignored",
+		Description: "updated",
+	})
+	require.NoError(t, err)
+
+	network, err := sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{NetworkId: networkID})
+	require.NoError(t, err)
+	assert.Equal(t, "test-network", network.Name)
+	assert.Equal(t, "updated", network.Description)
+	assert.Equal(t, map[string]string{"key": "value"}, network.Labels)
+
+	subnets, err := sdk.VPC().Network().ListSubnets(ctx, &vpc.ListNetworkSubnetsRequest{NetworkId: networkID})
+	require.NoError(t, err)
+	require.Len(t, subnets.Subnets, 1)
+	assert.Equal(t, subnetID, subnets.Subnets[0].Id)
+
+	_, err = sdk.VPC().Subnet().Delete(ctx, &vpc.DeleteSubnetRequest{SubnetId: subnetID})
+	require.NoError(t, err)
+	_, err = sdk.VPC().Network().Delete(ctx, &vpc.DeleteNetworkRequest{NetworkId: networkID})
+	require.NoError(t, err)
+
+	_, err = sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{NetworkId: networkID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestHoldOperations(t *testing.T) {
+	ctx := context.Background()
+	s, sdk := newTestSDK(t)
+
+	s.HoldOperations()
+	created, err := sdk.VPC().Network().Create(ctx, &vpc.CreateNetworkRequest{FolderId: s.FolderID, Name: "test-network"})
+	require.NoError(t, err)
+	assert.False(t, created.Done)
+
+	op, err := sdk.Operation().Get(ctx, &operation.GetOperationRequest{OperationId: created.Id})
+	require.NoError(t, err)
+	assert.False(t, op.Done)
+
+	s.CompleteOperations()
+	op, err = sdk.Operation().Get(ctx, &operation.GetOperationRequest{OperationId: created.Id})
+	require.NoError(t, err)
+	assert.True(t, op.Done)
+	assert.NotNil(t, op.GetResponse())
+}
+
+func TestComputeDiskAccessBindings(t *testing.T) {
+	ctx := context.Background()
+	s, sdk := newTestSDK(t)
+
+	op, err := sdk.WrapOperation(sdk.Compute().Disk().Create(ctx, &compute.CreateDiskRequest{
+		FolderId: s.FolderID,
+		Name:     "test-disk",
+		ZoneId:   DefaultZone,
+		Size:     1 << 30,
+	}))
+	require.NoError(t, err)
+	resp, err := op.Response()
+	require.NoError(t, err)
+	disk := resp.(*compute.Disk)
+	assert.Equal(t, defaultDiskType, disk.TypeId)
+	assert.Equal(t, compute.Disk_READY, disk.Status)
+
+	subject := &access.Subject{Id: "aje00000000000000001", Type: "userAccount"}
+	_, err = sdk.Compute().Disk().UpdateAccessBindings(ctx, &access.UpdateAccessBindingsRequest{
+		ResourceId: disk.Id,
+		AccessBindingDeltas: []*access.AccessBindingDelta{{
+			Action:        access.AccessBindingAction_ADD,
+			AccessBinding: &access.AccessBinding{RoleId: "viewer", Subject: subject},
+		}},
+	})
+	require.NoError(t, err)
+
+	bindings, err := sdk.Compute().Disk().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{ResourceId: disk.Id})
+	require.NoError(t, err)
+	require.Len(t, bindings.AccessBindings, 1)
+	assert.Equal(t, "viewer", bindings.AccessBindings[0].RoleId)
+
+	_, err = sdk.Compute().Disk().SetAccessBindings(ctx, &access.SetAccessBindingsRequest{ResourceId: disk.Id})
+	require.NoError(t, err)
+
+	bindings, err = sdk.Compute().Disk().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{ResourceId: disk.Id})
+	require.NoError(t, err)
+	assert.Empty(t, bindings.AccessBindings)
+}
@@ -0,0 +1,261 @@
+package fakecloud
+
+import (
+	"context"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type networkService struct {
+	vpc.UnimplementedNetworkServiceServer
+	s *Server
+}
+
+func (n *networkService) Get(_ context.Context, req *vpc.GetNetworkRequest) (*vpc.Network, error) {
+	n.s.mu.Lock()
+	defer n.s.mu.Unlock()
+
+	network, ok := n.s.networks[req.GetNetworkId()]
+	if !ok {
+		return nil, notFound("Network", req.GetNetworkId())
+	}
+	return proto.Clone(network).(*vpc.Network), nil
+}
+
+func (n *networkService) List(_ context.Context, req *vpc.ListNetworksRequest) (*vpc.ListNetworksResponse, error) {
+	n.s.mu.Lock()
+	defer n.s.mu.Unlock()
+
+	if req.GetFolderId() == "" {
+		return nil, required("folder_id")
+	}
+
+	resp := &vpc.ListNetworksResponse{}
+	for _, network := range n.s.networks {
+		if network.FolderId != req.GetFolderId() {
+			continue
+		}
+		ok, err := matchesFilter(req.GetFilter(), network.Name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			resp.Networks = append(resp.Networks, proto.Clone(network).(*vpc.Network))
+		}
+	}
+	return resp, nil
+}
+
+func (n *networkService) Create(_ context.Context, req *vpc.CreateNetworkRequest) (*operation.Operation, error) {
+	n.s.mu.Lock()
+	defer n.s.mu.Unlock()
+
+	if err := n.s.checkFolder(req.GetFolderId()); err != nil {
+		return nil, err
+	}
+
+	network := &vpc.Network{
+		Id:                     n.s.newID("enp"),
+		FolderId:               req.GetFolderId(),
+		CreatedAt:              now(),
+		Name:                   req.GetName(),
+		Description:            req.GetDescription(),
+		Labels:                 req.GetLabels(),
+		DefaultSecurityGroupId: n.s.newID("enp"),
+	}
+	n.s.networks[network.Id] = network
+
+	return n.s.newOperation(describe("Create", "network", network.Id),
+		&vpc.CreateNetworkMetadata{NetworkId: network.Id}, network)
+}
+
+func (n *networkService) Update(_ context.Context, req *vpc.UpdateNetworkRequest) (*operation.Operation, error) {
+	n.s.mu.Lock()
+	defer n.s.mu.Unlock()
+
+	network, ok := n.s.networks[req.GetNetworkId()]
+	if !ok {
+		return nil, notFound("Network", req.GetNetworkId())
+	}
+
+	updated := proto.Clone(network).(*vpc.Network)
+	for _, path := range updatePaths(req.GetUpdateMask(), "name", "description", "labels") {
+		switch path {
+		case "name":
+			updated.Name = req.GetName()
+		case "description":
+			updated.Description = req.GetDescription()
+		case "labels":
+			updated.Labels = req.GetLabels()
+		default:
+			return nil, unknownField(path)
+		}
+	}
+	n.s.networks[updated.Id] = updated
+
+	return n.s.newOperation(describe("Update", "network", updated.Id),
+		&vpc.UpdateNetworkMetadata{NetworkId: updated.Id}, updated)
+}
+
+func (n *networkService) Delete(_ context.Context, req *vpc.DeleteNetworkRequest) (*operation.Operation, error) {
+	n.s.mu.Lock()
+	defer n.s.mu.Unlock()
+
+	if _, ok := n.s.networks[req.GetNetworkId()]; !ok {
+		return nil, notFound("Network", req.GetNetworkId())
+	}
+	for _, subnet := range n.s.subnets {
+		if subnet.NetworkId == req.GetNetworkId() {
+			return nil, status.Errorf(codes.FailedPrecondition, "Network %s is not empty", req.GetNetworkId())
+		}
+	}
+	delete(n.s.networks, req.GetNetworkId())
+
+	return n.s.newOperation(describe("Delete", "network", req.GetNetworkId()),
+		&vpc.DeleteNetworkMetadata{NetworkId: req.GetNetworkId()}, nil)
+}
+
+func (n *networkService) ListSubnets(_ context.Context, req *vpc.ListNetworkSubnetsRequest) (*vpc.ListNetworkSubnetsResponse, error) {
+	n.s.mu.Lock()
+	defer n.s.mu.Unlock()
+
+	if _, ok := n.s.networks[req.GetNetworkId()]; !ok {
+		return nil, notFound("Network", req.GetNetworkId())
+	}
+
+	resp := &vpc.ListNetworkSubnetsResponse{}
+	for _, subnet := range n.s.subnets {
+		if subnet.NetworkId == req.GetNetworkId() {
+			resp.Subnets = append(resp.Subnets, proto.Clone(subnet).(*vpc.Subnet))
+		}
+	}
+	return resp, nil
+}
+
+type subnetService struct {
+	vpc.UnimplementedSubnetServiceServer
+	s *Server
+}
+
+func (sn *subnetService) Get(_ context.Context, req *vpc.GetSubnetRequest) (*vpc.Subnet, error) {
+	sn.s.mu.Lock()
+	defer sn.s.mu.Unlock()
+
+	subnet, ok := sn.s.subnets[req.GetSubnetId()]
+	if !ok {
+		return nil, notFound("Subnet", req.GetSubnetId())
+	}
+	return proto.Clone(subnet).(*vpc.Subnet), nil
+}
+
+func (sn *subnetService) List(_ context.Context, req *vpc.ListSubnetsRequest) (*vpc.ListSubnetsResponse, error) {
+	sn.s.mu.Lock()
+	defer sn.s.mu.Unlock()
+
+	if req.GetFolderId() == "" {
+		return nil, required("folder_id")
+	}
+
+	resp := &vpc.ListSubnetsResponse{}
+	for _, subnet := range sn.s.subnets {
+		if subnet.FolderId != req.GetFolderId() {
+			continue
+		}
+		ok, err := matchesFilter(req.GetFilter(), subnet.Name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			resp.Subnets = append(resp.Subnets, proto.Clone(subnet).(*vpc.Subnet))
+		}
+	}
+	return resp, nil
+}
+
+func (sn *subnetService) Create(_ context.Context, req *vpc.CreateSubnetRequest) (*operation.Operation, error) {
+	sn.s.mu.Lock()
+	defer sn.s.mu.Unlock()
+
+	if err := sn.s.checkFolder(req.GetFolderId()); err != nil {
+		return nil, err
+	}
+	if _, ok := sn.s.networks[req.GetNetworkId()]; !ok {
+		return nil, notFound("Network", req.GetNetworkId())
+	}
+	if req.GetZoneId() == "" {
+		return nil, required("zone_id")
+	}
+	if len(req.GetV4CidrBlocks()) == 0 {
+		return nil, required("v4_cidr_blocks")
+	}
+
+	subnet := &vpc.Subnet{
+		Id:           sn.s.newID("e9b"),
+		FolderId:     req.GetFolderId(),
+		CreatedAt:    now(),
+		Name:         req.GetName(),
+		Description:  req.GetDescription(),
+		Labels:       req.GetLabels(),
+		NetworkId:    req.GetNetworkId(),
+		ZoneId:       req.GetZoneId(),
+		V4CidrBlocks: req.GetV4CidrBlocks(),
+		RouteTableId: req.GetRouteTableId(),
+		DhcpOptions:  req.GetDhcpOptions(),
+	}
+	sn.s.subnets[subnet.Id] = subnet
+
+	return sn.s.newOperation(describe("Create", "subnet", subnet.Id),
+		&vpc.CreateSubnetMetadata{SubnetId: subnet.Id}, subnet)
+}
+
+func (sn *subnetService) Update(_ context.Context, req *vpc.UpdateSubnetRequest) (*operation.Operation, error) {
+	sn.s.mu.Lock()
+	defer sn.s.mu.Unlock()
+
+	subnet, ok := sn.s.subnets[req.GetSubnetId()]
+	if !ok {
+		return nil, notFound("Subnet", req.GetSubnetId())
+	}
+
+	updated := proto.Clone(subnet).(*vpc.Subnet)
+	paths := updatePaths(req.GetUpdateMask(), "name", "description", "labels", "route_table_id", "dhcp_options", "v4_cidr_blocks")
+	for _, path := range paths {
+		switch path {
+		case "name":
+			updated.Name = req.GetName()
+		case "description":
+			updated.Description = req.GetDescription()
+		case "labels":
+			updated.Labels = req.GetLabels()
+		case "route_table_id":
+			updated.RouteTableId = req.GetRouteTableId()
+		case "dhcp_options":
+			updated.DhcpOptions = req.GetDhcpOptions()
+		case "v4_cidr_blocks":
+			updated.V4CidrBlocks = req.GetV4CidrBlocks()
+		default:
+			return nil, unknownField(path)
+		}
+	}
+	sn.s.subnets[updated.Id] = updated
+
+	return sn.s.newOperation(describe("Update", "subnet", updated.Id),
+		&vpc.UpdateSubnetMetadata{SubnetId: updated.Id}, updated)
+}
+
+func (sn *subnetService) Delete(_ context.Context, req *vpc.DeleteSubnetRequest) (*operation.Operation, error) {
+	sn.s.mu.Lock()
+	defer sn.s.mu.Unlock()
+
+	if _, ok := sn.s.subnets[req.GetSubnetId()]; !ok {
+		return nil, notFound("Subnet", req.GetSubnetId())
+	}
+	delete(sn.s.subnets, req.GetSubnetId())
+
+	return sn.s.newOperation(describe("Delete", "subnet", req.GetSubnetId()),
+		&vpc.DeleteSubnetMetadata{SubnetId: req.GetSubnetId()}, nil)
+}
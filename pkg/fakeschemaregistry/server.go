@@ -0,0 +1,275 @@
+// Package fakeschemaregistry implements an in-process fake of the Confluent-compatible Schema Registry REST API.
+//
+// The fake keeps all the state in memory and implements the subset of the API used by
+// the schemaregistry client: registering schemas, reading the latest version of a subject,
+// managing subject compatibility levels and deleting subjects.
+package fakeschemaregistry
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/schemaregistry"
+)
+
+const (
+	// Username and Password are the credentials accepted by the fake server.
+	Username = "fake-user"
+	Password = "fake-password"
+
+	// GlobalCompatibilityLevel is the compatibility level of the subjects without their own level.
+	GlobalCompatibilityLevel = "BACKWARD"
+)
+
+// Server is an in-process fake Schema Registry server.
+type Server struct {
+	// URL is the endpoint of the server, e.g. http://127.0.0.1:1234.
+	URL string
+
+	httpServer *httptest.Server
+
+	mu       sync.Mutex
+	lastID   int
+	subjects map[string]*subject
+}
+
+type subject struct {
+	versions           []schemaregistry.Schema
+	compatibilityLevel string
+	deleted            bool
+}
+
+// Start starts the fake server listening on a random local port.
+func Start() *Server {
+	s := &Server{subjects: map[string]*subject{}}
+	s.httpServer = httptest.NewServer(s)
+	s.URL = s.httpServer.URL
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.httpServer.Close()
+}
+
+// LatestSchema returns the latest version of the schema registered under the subject.
+func (s *Server) LatestSchema(name string) (*schemaregistry.Schema, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub, ok := s.subjects[name]
+	if !ok || sub.deleted {
+		return nil, false
+	}
+	latest := sub.versions[len(sub.versions)-1]
+	return &latest, true
+}
+
+// CompatibilityLevel returns the compatibility level set for the subject, if any.
+func (s *Server) CompatibilityLevel(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sub, ok := s.subjects[name]; ok {
+		return sub.compatibilityLevel
+	}
+	return ""
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if username, password, ok := r.BasicAuth(); !ok || username != Username || password != Password {
+		writeError(w, http.StatusUnauthorized, 40101, "Unauthorized")
+		return
+	}
+
+	var elems []string
+	for _, elem := range strings.Split(strings.Trim(r.URL.EscapedPath(), "/"), "/") {
+		unescaped, err := url.PathUnescape(elem)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, 400, "invalid path")
+			return
+		}
+		elems = append(elems, unescaped)
+	}
+
+	switch {
+	case len(elems) == 3 && elems[0] == "subjects" && elems[2] == "versions" && r.Method == http.MethodPost:
+		s.registerSchema(w, r, elems[1])
+	case len(elems) == 4 && elems[0] == "subjects" && elems[2] == "versions" && elems[3] == "latest" && r.Method == http.MethodGet:
+		s.getLatestSchema(w, elems[1])
+	case len(elems) == 2 && elems[0] == "subjects" && r.Method == http.MethodDelete:
+		s.deleteSubject(w, r, elems[1])
+	case len(elems) == 2 && elems[0] == "config" && r.Method == http.MethodGet:
+		s.getConfig(w, r, elems[1])
+	case len(elems) == 2 && elems[0] == "config" && r.Method == http.MethodPut:
+		s.setConfig(w, r, elems[1])
+	default:
+		writeError(w, http.StatusNotFound, 404, "HTTP 404 Not Found")
+	}
+}
+
+// registerSchema registers a new version of the subject unless it is the same as the latest one.
+// Must be called with s.mu held.
+func (s *Server) registerSchema(w http.ResponseWriter, r *http.Request, name string) {
+	var req schemaregistry.Schema
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Schema == "" {
+		writeError(w, http.StatusUnprocessableEntity, 42201, "Invalid schema")
+		return
+	}
+	if req.SchemaType == "" {
+		req.SchemaType = "AVRO"
+	}
+	if !slices.Contains(schemaregistry.SchemaTypes, req.SchemaType) {
+		writeError(w, http.StatusUnprocessableEntity, 42201, "Invalid schema type "+req.SchemaType)
+		return
+	}
+
+	sub, ok := s.subjects[name]
+	if !ok {
+		sub = &subject{}
+		s.subjects[name] = sub
+	}
+	if !sub.deleted && len(sub.versions) != 0 {
+		latest := sub.versions[len(sub.versions)-1]
+		if sameSchema(latest, req) {
+			writeJSON(w, map[string]int{"id": latest.ID})
+			return
+		}
+	}
+
+	id := s.schemaID(req)
+	version := 1
+	if len(sub.versions) != 0 {
+		version = sub.versions[len(sub.versions)-1].Version + 1
+	}
+	sub.deleted = false
+	sub.versions = append(sub.versions, schemaregistry.Schema{
+		Subject:    name,
+		ID:         id,
+		Version:    version,
+		SchemaType: req.SchemaType,
+		Schema:     req.Schema,
+		References: req.References,
+	})
+	writeJSON(w, map[string]int{"id": id})
+}
+
+// schemaID returns the global ID of the schema, assigning a new one to the schema never registered before.
+// Must be called with s.mu held.
+func (s *Server) schemaID(schema schemaregistry.Schema) int {
+	for _, sub := range s.subjects {
+		for _, v := range sub.versions {
+			if sameSchema(v, schema) {
+				return v.ID
+			}
+		}
+	}
+	s.lastID++
+	return s.lastID
+}
+
+// getLatestSchema returns the latest version of the subject. Must be called with s.mu held.
+func (s *Server) getLatestSchema(w http.ResponseWriter, name string) {
+	sub, ok := s.subjects[name]
+	if !ok || sub.deleted {
+		writeError(w, http.StatusNotFound, 40401, "Subject '"+name+"' not found.")
+		return
+	}
+
+	latest := sub.versions[len(sub.versions)-1]
+	// AVRO is the default type and is omitted in responses.
+	if latest.SchemaType == "AVRO" {
+		latest.SchemaType = ""
+	}
+	writeJSON(w, latest)
+}
+
+// deleteSubject soft or permanently deletes the subject. Must be called with s.mu held.
+func (s *Server) deleteSubject(w http.ResponseWriter, r *http.Request, name string) {
+	sub, ok := s.subjects[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, 40401, "Subject '"+name+"' not found.")
+		return
+	}
+
+	permanent := r.URL.Query().Get("permanent") == "true"
+	switch {
+	case permanent && !sub.deleted:
+		writeError(w, http.StatusNotFound, 40405, "Subject '"+name+"' was not deleted first before being permanently deleted")
+		return
+	case !permanent && sub.deleted:
+		writeError(w, http.StatusNotFound, 40404, "Subject '"+name+"' was soft deleted.")
+		return
+	}
+
+	versions := make([]int, 0, len(sub.versions))
+	for _, v := range sub.versions {
+		versions = append(versions, v.Version)
+	}
+	if permanent {
+		delete(s.subjects, name)
+	} else {
+		sub.deleted = true
+	}
+	writeJSON(w, versions)
+}
+
+// getConfig returns the compatibility level of the subject. Must be called with s.mu held.
+func (s *Server) getConfig(w http.ResponseWriter, r *http.Request, name string) {
+	level := ""
+	if sub, ok := s.subjects[name]; ok {
+		level = sub.compatibilityLevel
+	}
+	if level == "" {
+		if r.URL.Query().Get("defaultToGlobal") != "true" {
+			writeError(w, http.StatusNotFound, 40408, "Subject '"+name+"' does not have subject-level compatibility configured")
+			return
+		}
+		level = GlobalCompatibilityLevel
+	}
+	writeJSON(w, map[string]string{"compatibilityLevel": level})
+}
+
+// setConfig sets the compatibility level of the subject. Must be called with s.mu held.
+func (s *Server) setConfig(w http.ResponseWriter, r *http.Request, name string) {
+	var req struct {
+		Compatibility string `json:"compatibility"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || !slices.Contains(schemaregistry.CompatibilityLevels, req.Compatibility) {
+		writeError(w, http.StatusUnprocessableEntity, 42203, "Invalid compatibility level")
+		return
+	}
+
+	sub, ok := s.subjects[name]
+	if !ok {
+		sub = &subject{deleted: true}
+		s.subjects[name] = sub
+	}
+	sub.compatibilityLevel = req.Compatibility
+	writeJSON(w, req)
+}
+
+func sameSchema(a, b schemaregistry.Schema) bool {
+	return a.Schema == b.Schema && a.SchemaType == b.SchemaType &&
+		(len(a.References) == 0 && len(b.References) == 0 || reflect.DeepEqual(a.References, b.References))
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status, code int, message string) {
+	w.Header().Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(schemaregistry.Error{ErrorCode: code, Message: message})
+}
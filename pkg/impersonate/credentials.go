@@ -0,0 +1,107 @@
+// Package impersonate implements the Yandex Cloud API credentials of a service account impersonated
+// on behalf of other credentials, optionally through a chain of delegate service accounts.
+package impersonate
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/grpc"
+)
+
+// Credentials get an IAM token of the service account with IamTokenService.CreateForServiceAccount.
+//
+// The base credentials get a token of the first delegate, which gets a token of the next one, and so on,
+// the last delegate gets a token of the service account. Every account in the chain must have
+// the `iam.serviceAccounts.tokenCreator` role on the next one.
+//
+// They implement ycsdk.NonExchangeableCredentials: the SDK caches the IAM token and requests a new one
+// when it expires.
+type Credentials struct {
+	ServiceAccountID string
+	Delegates        []string
+
+	config ycsdk.Config
+	opts   []grpc.DialOption
+
+	mu   sync.Mutex
+	base *ycsdk.SDK
+}
+
+// New returns the credentials of the service account impersonated on behalf of the credentials of the config.
+// The config and the dial options are used to build the SDK calling IamTokenService.
+func New(config ycsdk.Config, serviceAccountID string, delegates []string, opts ...grpc.DialOption) (*Credentials, error) {
+	if serviceAccountID == "" {
+		return nil, fmt.Errorf("impersonated service account ID should be specified")
+	}
+	if config.Credentials == nil {
+		return nil, fmt.Errorf("credentials to impersonate service account %s should be specified", serviceAccountID)
+	}
+	for _, d := range delegates {
+		if d == "" {
+			return nil, fmt.Errorf("delegate service account ID should not be empty")
+		}
+	}
+	return &Credentials{
+		ServiceAccountID: serviceAccountID,
+		Delegates:        delegates,
+		config:           config,
+		opts:             opts,
+	}, nil
+}
+
+func (c *Credentials) YandexCloudAPICredentials() {}
+
+// IAMToken gets an IAM token of the service account through the chain of delegates.
+func (c *Credentials) IAMToken(ctx context.Context) (*iam.CreateIamTokenResponse, error) {
+	sdk, err := c.baseSDK(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := append(append([]string{}, c.Delegates...), c.ServiceAccountID)
+	var token *iam.CreateIamTokenResponse
+	for i, serviceAccountID := range chain {
+		if i > 0 {
+			// The SDK authenticates IamTokenService calls with its own credentials,
+			// so the next account in the chain needs the SDK with the token of the previous one.
+			config := c.config
+			config.Credentials = ycsdk.NewIAMTokenCredentials(token.IamToken)
+			sdk, err = ycsdk.Build(ctx, config, c.opts...)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		token, err = sdk.CreateIAMTokenForServiceAccount(ctx, serviceAccountID)
+		if i > 0 {
+			_ = sdk.Shutdown(ctx)
+		}
+		if err != nil {
+			if i == 0 {
+				return nil, fmt.Errorf("failed to impersonate service account %s: %w", serviceAccountID, err)
+			}
+			return nil, fmt.Errorf("failed to impersonate service account %s by %s: %w", serviceAccountID, chain[i-1], err)
+		}
+	}
+	return token, nil
+}
+
+// baseSDK returns the SDK authenticated with the base credentials. It is built once to cache their IAM token.
+func (c *Credentials) baseSDK(ctx context.Context) (*ycsdk.SDK, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.base != nil {
+		return c.base, nil
+	}
+	sdk, err := ycsdk.Build(ctx, c.config, c.opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.base = sdk
+	return sdk, nil
+}
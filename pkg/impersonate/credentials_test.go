@@ -0,0 +1,130 @@
+package impersonate
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/endpoint"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const baseToken = "t1.base.token"
+
+func tokenOf(serviceAccountID string) string {
+	return "t1." + serviceAccountID + ".token"
+}
+
+// iamStub issues IAM tokens of the service accounts to the callers having the token creator role on them.
+type iamStub struct {
+	iam.UnimplementedIamTokenServiceServer
+	endpoint.UnimplementedApiEndpointServiceServer
+
+	address string
+	// tokenCreators maps the service account to the token of the account having the token creator role on it.
+	tokenCreators map[string]string
+
+	mu    sync.Mutex
+	calls []string
+}
+
+func (s *iamStub) List(context.Context, *endpoint.ListApiEndpointsRequest) (*endpoint.ListApiEndpointsResponse, error) {
+	return &endpoint.ListApiEndpointsResponse{Endpoints: []*endpoint.ApiEndpoint{
+		{Id: "endpoint", Address: s.address},
+		{Id: "iam", Address: s.address},
+	}}, nil
+}
+
+func (s *iamStub) CreateForServiceAccount(ctx context.Context, req *iam.CreateIamTokenForServiceAccountRequest) (*iam.CreateIamTokenResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	auth := md.Get("authorization")
+	if len(auth) != 1 {
+		return nil, status.Errorf(codes.Unauthenticated, "expected one authorization header, got %d", len(auth))
+	}
+	caller := strings.TrimPrefix(auth[0], "Bearer ")
+
+	s.mu.Lock()
+	s.calls = append(s.calls, req.GetServiceAccountId())
+	s.mu.Unlock()
+
+	if s.tokenCreators[req.GetServiceAccountId()] != caller {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied to service account %s", req.GetServiceAccountId())
+	}
+	return &iam.CreateIamTokenResponse{
+		IamToken:  tokenOf(req.GetServiceAccountId()),
+		ExpiresAt: timestamppb.New(time.Now().Add(time.Hour)),
+	}, nil
+}
+
+func startStub(t *testing.T) (*iamStub, ycsdk.Config) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	stub := &iamStub{
+		address: listener.Addr().String(),
+		tokenCreators: map[string]string{
+			"sa-ci":     baseToken,
+			"sa-folder": tokenOf("sa-ci"),
+			"sa-target": tokenOf("sa-folder"),
+		},
+	}
+	server := grpc.NewServer()
+	endpoint.RegisterApiEndpointServiceServer(server, stub)
+	iam.RegisterIamTokenServiceServer(server, stub)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	return stub, ycsdk.Config{
+		Credentials: ycsdk.NewIAMTokenCredentials(baseToken),
+		Endpoint:    stub.address,
+		Plaintext:   true,
+	}
+}
+
+func TestIAMToken(t *testing.T) {
+	stub, config := startStub(t)
+
+	creds, err := New(config, "sa-ci", nil)
+	require.NoError(t, err)
+	token, err := creds.IAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, tokenOf("sa-ci"), token.IamToken)
+
+	creds, err = New(config, "sa-target", []string{"sa-ci", "sa-folder"})
+	require.NoError(t, err)
+	token, err = creds.IAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, tokenOf("sa-target"), token.IamToken)
+	assert.Equal(t, []string{"sa-ci", "sa-ci", "sa-folder", "sa-target"}, stub.calls)
+
+	creds, err = New(config, "sa-target", []string{"sa-ci"})
+	require.NoError(t, err)
+	_, err = creds.IAMToken(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Contains(t, err.Error(), "failed to impersonate service account sa-target by sa-ci")
+}
+
+func TestNew(t *testing.T) {
+	config := ycsdk.Config{Credentials: ycsdk.NewIAMTokenCredentials(baseToken)}
+
+	_, err := New(config, "", nil)
+	assert.Error(t, err)
+	_, err = New(config, "sa-target", []string{""})
+	assert.Error(t, err)
+	_, err = New(ycsdk.Config{}, "sa-target", nil)
+	assert.Error(t, err)
+}
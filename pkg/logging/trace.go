@@ -0,0 +1,207 @@
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	clientRequestIDHeader = "x-client-request-id"
+	serverRequestIDHeader = "x-request-id"
+
+	redactedValue = "***"
+)
+
+// TraceEntry is a line of the API trace file, describing a single unary call.
+type TraceEntry struct {
+	Time            time.Time       `json:"time"`
+	Method          string          `json:"method"`
+	RequestType     string          `json:"request_type,omitempty"`
+	Request         json.RawMessage `json:"request,omitempty"`
+	ResponseType    string          `json:"response_type,omitempty"`
+	Response        json.RawMessage `json:"response,omitempty"`
+	Status          TraceStatus     `json:"status"`
+	RequestID       string          `json:"request_id,omitempty"`
+	ServerRequestID string          `json:"server_request_id,omitempty"`
+	LatencyMS       int64           `json:"latency_ms"`
+}
+
+// TraceStatus is the gRPC status of the traced call.
+type TraceStatus struct {
+	Code    string `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+var (
+	traceWritersMu sync.Mutex
+	traceWriters   = make(map[string]*traceWriter)
+)
+
+// NewTraceFileInterceptor returns the interceptor appending every unary call as a JSON line to the file.
+// Sensitive fields of requests and responses are redacted, see RedactSensitive.
+//
+// The interceptors created for the same file share the writer, so that the calls made by
+// the SDKv2 and the framework providers are not interleaved within a line.
+func NewTraceFileInterceptor(path string) (grpc.UnaryClientInterceptor, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	traceWritersMu.Lock()
+	defer traceWritersMu.Unlock()
+
+	w, ok := traceWriters[path]
+	if !ok {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open API trace file: %w", err)
+		}
+		w = &traceWriter{file: f}
+		traceWriters[path] = w
+	}
+	return w.InterceptUnary, nil
+}
+
+type traceWriter struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func (w *traceWriter) InterceptUnary(
+	ctx context.Context,
+	method string,
+	req, resp interface{},
+	conn *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	var header metadata.MD
+	opts = append(opts, grpc.Header(&header))
+
+	started := time.Now()
+	err := invoker(ctx, method, req, resp, conn, opts...)
+
+	entry := TraceEntry{
+		Time:            started.UTC(),
+		Method:          method,
+		LatencyMS:       time.Since(started).Milliseconds(),
+		ServerRequestID: firstValue(header, serverRequestIDHeader),
+	}
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		entry.RequestID = firstValue(md, clientRequestIDHeader)
+	}
+	entry.RequestType, entry.Request = marshalTraced(req)
+	if err == nil {
+		entry.ResponseType, entry.Response = marshalTraced(resp)
+	}
+	st := status.Convert(err)
+	entry.Status = TraceStatus{Code: st.Code().String(), Message: st.Message()}
+
+	w.write(entry)
+	return err
+}
+
+func (w *traceWriter) write(entry TraceEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	line = append(line, '\n')
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_, _ = w.file.Write(line)
+}
+
+func marshalTraced(m interface{}) (string, json.RawMessage) {
+	msg, ok := m.(protov2.Message)
+	if !ok || IsNil(m) {
+		return "", nil
+	}
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(RedactSensitive(msg))
+	if err != nil {
+		return "", nil
+	}
+	return string(msg.ProtoReflect().Descriptor().FullName()), b
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// sensitiveFieldNames are the suffixes of the names of the fields treated as sensitive.
+var sensitiveFieldNames = []string{"password", "secret", "private_key", "iam_token", "access_token", "refresh_token", "oauth_token", "jwt"}
+
+// RedactSensitive returns a copy of the message with the values of the sensitive fields replaced.
+// The API protos have no option marking sensitive fields, so a field is sensitive if its name is
+// `token`, `text_value`, `binary_value` or ends with a well-known secret name like `password`.
+func RedactSensitive(m protov2.Message) protov2.Message {
+	m = protov2.Clone(m)
+	redact(m.ProtoReflect())
+	return m
+}
+
+func redact(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if isSensitive(fd) {
+			switch {
+			case fd.IsList() || fd.IsMap():
+				m.Clear(fd)
+			case fd.Kind() == protoreflect.StringKind:
+				m.Set(fd, protoreflect.ValueOfString(redactedValue))
+			case fd.Kind() == protoreflect.BytesKind:
+				m.Set(fd, protoreflect.ValueOfBytes([]byte(redactedValue)))
+			default:
+				m.Clear(fd)
+			}
+			return true
+		}
+
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redact(list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				redact(mv.Message())
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			redact(v.Message())
+		}
+		return true
+	})
+}
+
+func isSensitive(fd protoreflect.FieldDescriptor) bool {
+	name := string(fd.Name())
+	switch name {
+	case "token", "text_value", "binary_value":
+		return true
+	}
+	for _, suffix := range sensitiveFieldNames {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package logging
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/go-sdk/pkg/requestid"
+	"google.golang.org/grpc"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+func TestRedactSensitive(t *testing.T) {
+	user := &postgresql.CreateUserRequest{
+		ClusterId: "cid",
+		UserSpec: &postgresql.UserSpec{
+			Name:     "alice",
+			Password: "qwerty",
+		},
+	}
+	redacted := RedactSensitive(user).(*postgresql.CreateUserRequest)
+	assert.Equal(t, "alice", redacted.UserSpec.Name)
+	assert.Equal(t, redactedValue, redacted.UserSpec.Password)
+	assert.Equal(t, "qwerty", user.UserSpec.Password, "original message must not be modified")
+
+	version := &lockbox.AddVersionRequest{
+		SecretId: "sid",
+		PayloadEntries: []*lockbox.PayloadEntryChange{
+			{Key: "text", Value: &lockbox.PayloadEntryChange_TextValue{TextValue: "secret"}},
+			{Key: "binary", Value: &lockbox.PayloadEntryChange_BinaryValue{BinaryValue: []byte("secret")}},
+		},
+	}
+	redactedVersion := RedactSensitive(version).(*lockbox.AddVersionRequest)
+	assert.Equal(t, "sid", redactedVersion.SecretId)
+	assert.Equal(t, "text", redactedVersion.PayloadEntries[0].Key)
+	assert.Equal(t, redactedValue, redactedVersion.PayloadEntries[0].GetTextValue())
+	assert.Equal(t, []byte(redactedValue), redactedVersion.PayloadEntries[1].GetBinaryValue())
+
+	oauth := &iam.CreateIamTokenRequest{
+		Identity: &iam.CreateIamTokenRequest_YandexPassportOauthToken{YandexPassportOauthToken: "y0_oauth"},
+	}
+	assert.Equal(t, redactedValue, RedactSensitive(oauth).(*iam.CreateIamTokenRequest).GetYandexPassportOauthToken())
+
+	jwt := &iam.CreateIamTokenRequest{
+		Identity: &iam.CreateIamTokenRequest_Jwt{Jwt: "eyJhbGciOiJQUzI1NiJ9.payload.signature"},
+	}
+	assert.Equal(t, redactedValue, RedactSensitive(jwt).(*iam.CreateIamTokenRequest).GetJwt())
+
+	page := &vpc.ListNetworksRequest{FolderId: "fid", PageToken: "next"}
+	assert.Equal(t, "next", RedactSensitive(page).(*vpc.ListNetworksRequest).PageToken)
+}
+
+func TestTraceFileInterceptor(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+
+	s, err := fakecloud.Start()
+	require.NoError(t, err)
+	defer s.Stop()
+
+	traceInterceptor, err := NewTraceFileInterceptor(path)
+	require.NoError(t, err)
+	sdk, err := ycsdk.Build(ctx, ycsdk.Config{
+		Credentials: ycsdk.NewIAMTokenCredentials(fakecloud.Token),
+		Endpoint:    s.Endpoint(),
+		Plaintext:   true,
+	}, grpc.WithChainUnaryInterceptor(requestid.Interceptor(), traceInterceptor))
+	require.NoError(t, err)
+	defer sdk.Shutdown(ctx)
+
+	_, err = sdk.VPC().Network().Create(ctx, &vpc.CreateNetworkRequest{FolderId: s.FolderID, Name: "traced"})
+	require.NoError(t, err)
+	_, err = sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{NetworkId: "missing"})
+	require.Error(t, err)
+
+	var entries []TraceEntry
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry TraceEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		if entry.Method == "/yandex.cloud.endpoint.ApiEndpointService/List" {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, entries, 2)
+
+	create := entries[0]
+	assert.Equal(t, "/yandex.cloud.vpc.v1.NetworkService/Create", create.Method)
+	assert.Equal(t, "yandex.cloud.vpc.v1.CreateNetworkRequest", create.RequestType)
+	assert.JSONEq(t, `{"folder_id": "`+s.FolderID+`", "name": "traced"}`, string(create.Request))
+	assert.Equal(t, "yandex.cloud.operation.Operation", create.ResponseType)
+	assert.Equal(t, "OK", create.Status.Code)
+	assert.NotEmpty(t, create.RequestID)
+
+	get := entries[1]
+	assert.Equal(t, "/yandex.cloud.vpc.v1.NetworkService/Get", get.Method)
+	assert.Equal(t, "NotFound", get.Status.Code)
+	assert.Empty(t, get.Response)
+}
@@ -0,0 +1,204 @@
+// Package ratelimit implements client-side limits of the request rate and of the number of concurrent requests
+// to Yandex Cloud services as a gRPC client interceptor.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// AllServices is the service name of the limit applied to the services without their own limit.
+const AllServices = ""
+
+// Limit limits requests to a Yandex Cloud service.
+type Limit struct {
+	// Service is the name of the service as in the gRPC package `yandex.cloud.<service>`, e.g. `iam` or `dns`.
+	Service string
+	// RequestsPerSecond is the maximum average request rate. Zero means no limit.
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests sent at once within RequestsPerSecond.
+	// Zero means RequestsPerSecond rounded up.
+	Burst int
+	// MaxConcurrentRequests is the maximum number of requests in flight. Zero means no limit.
+	MaxConcurrentRequests int
+}
+
+func (l Limit) String() string {
+	return fmt.Sprintf("%s:%g:%d:%d", l.Service, l.RequestsPerSecond, l.Burst, l.MaxConcurrentRequests)
+}
+
+// Validate checks that the limits are not negative and every service is limited once.
+func Validate(limits []Limit) error {
+	seen := make(map[string]bool, len(limits))
+	for _, l := range limits {
+		if seen[l.Service] {
+			if l.Service == AllServices {
+				return fmt.Errorf("rate limit for all services is specified more than once")
+			}
+			return fmt.Errorf("rate limit for service %q is specified more than once", l.Service)
+		}
+		seen[l.Service] = true
+
+		if l.RequestsPerSecond < 0 || l.Burst < 0 || l.MaxConcurrentRequests < 0 {
+			return fmt.Errorf("rate limit for service %q must not be negative", l.Service)
+		}
+	}
+	return nil
+}
+
+// ServiceFromMethod returns the service name of the full gRPC method name,
+// e.g. `iam` for `/yandex.cloud.iam.v1.ServiceAccountService/Get`.
+func ServiceFromMethod(method string) string {
+	name := strings.TrimPrefix(method, "/yandex.cloud.")
+	if name == method {
+		return ""
+	}
+	service, _, _ := strings.Cut(name, ".")
+	return service
+}
+
+var (
+	interceptorsMu sync.Mutex
+	interceptors   = make(map[string]*interceptor)
+)
+
+// NewInterceptor returns the interceptor limiting requests according to the limits.
+//
+// The interceptors created with equal limits share the state, so that the limits apply to
+// all the SDK clients built from one provider configuration, e.g. by the SDKv2 and the framework providers.
+func NewInterceptor(limits []Limit) grpc.UnaryClientInterceptor {
+	if len(limits) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(limits))
+	for _, l := range limits {
+		keys = append(keys, l.String())
+	}
+	key := strings.Join(keys, ",")
+
+	interceptorsMu.Lock()
+	defer interceptorsMu.Unlock()
+
+	i, ok := interceptors[key]
+	if !ok {
+		i = newInterceptor(limits)
+		interceptors[key] = i
+	}
+	return i.InterceptUnary
+}
+
+type interceptor struct {
+	services map[string]*limiter
+}
+
+func newInterceptor(limits []Limit) *interceptor {
+	i := &interceptor{services: make(map[string]*limiter, len(limits))}
+	for _, l := range limits {
+		i.services[l.Service] = newLimiter(l)
+	}
+	return i
+}
+
+func (i *interceptor) InterceptUnary(
+	ctx context.Context,
+	method string,
+	req, resp interface{},
+	conn *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	l, ok := i.services[ServiceFromMethod(method)]
+	if !ok {
+		l, ok = i.services[AllServices]
+	}
+	if !ok {
+		return invoker(ctx, method, req, resp, conn, opts...)
+	}
+
+	release, err := l.acquire(ctx, method)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return invoker(ctx, method, req, resp, conn, opts...)
+}
+
+type limiter struct {
+	concurrency chan struct{}
+
+	mu       sync.Mutex
+	interval time.Duration
+	burst    time.Duration
+	next     time.Time
+}
+
+func newLimiter(limit Limit) *limiter {
+	l := &limiter{}
+	if limit.MaxConcurrentRequests > 0 {
+		l.concurrency = make(chan struct{}, limit.MaxConcurrentRequests)
+	}
+	if limit.RequestsPerSecond > 0 {
+		burst := limit.Burst
+		if burst == 0 {
+			burst = int(math.Ceil(limit.RequestsPerSecond))
+		}
+		l.interval = time.Duration(float64(time.Second) / limit.RequestsPerSecond)
+		l.burst = time.Duration(burst-1) * l.interval
+	}
+	return l
+}
+
+// acquire waits until the request is allowed by the limits and returns the function to be called when it is done.
+func (l *limiter) acquire(ctx context.Context, method string) (func(), error) {
+	if l.concurrency != nil {
+		select {
+		case l.concurrency <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if l.concurrency != nil {
+			<-l.concurrency
+		}
+	}
+
+	if delay := l.reserve(time.Now()); delay > 0 {
+		log.Printf("[DEBUG] Request %s is delayed by %s due to the rate limit", method, delay)
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+	return release, nil
+}
+
+// reserve reserves the time slot for the request and returns the delay before it may be sent.
+func (l *limiter) reserve(now time.Time) time.Duration {
+	if l.interval == 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	next := l.next
+	if next.Before(now) {
+		next = now
+	}
+	l.next = next.Add(l.interval)
+	return next.Sub(now) - l.burst
+}
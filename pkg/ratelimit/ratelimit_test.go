@@ -0,0 +1,112 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestServiceFromMethod(t *testing.T) {
+	assert.Equal(t, "iam", ServiceFromMethod("/yandex.cloud.iam.v1.ServiceAccountService/Get"))
+	assert.Equal(t, "mdb", ServiceFromMethod("/yandex.cloud.mdb.postgresql.v1.ClusterService/Create"))
+	assert.Equal(t, "", ServiceFromMethod("/grpc.health.v1.Health/Check"))
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate(nil))
+	assert.NoError(t, Validate([]Limit{{RequestsPerSecond: 10}, {Service: "iam", MaxConcurrentRequests: 2}}))
+	assert.Error(t, Validate([]Limit{{Service: "iam"}, {Service: "iam"}}))
+	assert.Error(t, Validate([]Limit{{}, {}}))
+	assert.Error(t, Validate([]Limit{{Service: "dns", RequestsPerSecond: -1}}))
+}
+
+func TestLimiterReserve(t *testing.T) {
+	l := newLimiter(Limit{RequestsPerSecond: 10, Burst: 3})
+	now := time.Now()
+
+	// The burst is sent at once, the following requests are spread evenly.
+	for i := 0; i < 3; i++ {
+		assert.LessOrEqual(t, l.reserve(now), time.Duration(0))
+	}
+	assert.Equal(t, 100*time.Millisecond, l.reserve(now))
+	assert.Equal(t, 200*time.Millisecond, l.reserve(now))
+
+	// The bucket is refilled over time.
+	assert.LessOrEqual(t, l.reserve(now.Add(time.Second)), time.Duration(0))
+}
+
+func TestLimiterDefaultBurst(t *testing.T) {
+	l := newLimiter(Limit{RequestsPerSecond: 1.5})
+	now := time.Now()
+
+	assert.LessOrEqual(t, l.reserve(now), time.Duration(0))
+	assert.LessOrEqual(t, l.reserve(now), time.Duration(0))
+	assert.Greater(t, l.reserve(now), time.Duration(0))
+}
+
+func TestInterceptorConcurrency(t *testing.T) {
+	interceptor := NewInterceptor([]Limit{{Service: "iam", MaxConcurrentRequests: 2}})
+
+	var inFlight, maxInFlight int32
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := interceptor(context.Background(), "/yandex.cloud.iam.v1.RoleService/Get", nil, nil, nil, invoker)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), maxInFlight)
+}
+
+func TestInterceptorContextDone(t *testing.T) {
+	interceptor := NewInterceptor([]Limit{{RequestsPerSecond: 1, Burst: 1}})
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return nil
+	}
+
+	require.NoError(t, interceptor(context.Background(), "/yandex.cloud.dns.v1.DnsZoneService/Get", nil, nil, nil, invoker))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := interceptor(ctx, "/yandex.cloud.dns.v1.DnsZoneService/Get", nil, nil, nil, invoker)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestNewInterceptorSharesLimits(t *testing.T) {
+	assert.Nil(t, NewInterceptor(nil))
+
+	limits := []Limit{{Service: "vpc", RequestsPerSecond: 5}}
+	interceptorsMu.Lock()
+	before := len(interceptors)
+	interceptorsMu.Unlock()
+
+	NewInterceptor(limits)
+	NewInterceptor([]Limit{{Service: "vpc", RequestsPerSecond: 5}})
+
+	interceptorsMu.Lock()
+	defer interceptorsMu.Unlock()
+	assert.Equal(t, before+1, len(interceptors))
+}
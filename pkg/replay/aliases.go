@@ -0,0 +1,180 @@
+package replay
+
+import (
+	"regexp"
+	"strings"
+)
+
+// minRandomTokenLength is the minimal length of the tokens which are considered randomly generated.
+const minRandomTokenLength = 4
+
+var tokenRegexp = regexp.MustCompile(`[A-Za-z0-9]+`)
+
+// aliases bind the random tokens of the recorded requests, e.g. the names of the test resources generated
+// by acctest, to the tokens of the replayed requests. The tests use the global random source, so the
+// replayed run generates different names, or the same names in a different order, than the recorded one.
+type aliases struct {
+	// recorded maps the replayed tokens to the recorded ones.
+	recorded map[string]string
+	// replayed maps the recorded tokens to the replayed ones.
+	replayed map[string]string
+}
+
+func newAliases() *aliases {
+	return &aliases{recorded: map[string]string{}, replayed: map[string]string{}}
+}
+
+// toRecorded replaces the bound tokens of the replayed request with the recorded ones.
+func (a *aliases) toRecorded(v interface{}) interface{} {
+	return mapStrings(v, func(s string) string { return replaceTokens(s, a.recorded) })
+}
+
+// toReplayed replaces the bound tokens of the recorded response with the replayed ones.
+func (a *aliases) toReplayed(v interface{}) interface{} {
+	return mapStrings(v, func(s string) string { return replaceTokens(s, a.replayed) })
+}
+
+// bind returns the new bindings making the replayed request equal to the recorded one,
+// or false if the requests differ in anything but the random tokens not bound yet.
+func (a *aliases) bind(recorded, replayed interface{}) (map[string]string, bool) {
+	bindings := map[string]string{}
+	if !a.bindValue(recorded, replayed, bindings) {
+		return nil, false
+	}
+	return bindings, true
+}
+
+// add adds the bindings of the replayed tokens to the recorded ones.
+func (a *aliases) add(bindings map[string]string) {
+	for replayed, recorded := range bindings {
+		a.recorded[replayed] = recorded
+		a.replayed[recorded] = replayed
+	}
+}
+
+func (a *aliases) bindValue(recorded, replayed interface{}, bindings map[string]string) bool {
+	switch r := recorded.(type) {
+	case map[string]interface{}:
+		p, ok := replayed.(map[string]interface{})
+		if !ok || len(p) != len(r) {
+			return false
+		}
+		for k, rv := range r {
+			pv, ok := p[k]
+			if !ok || !a.bindValue(rv, pv, bindings) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		p, ok := replayed.([]interface{})
+		if !ok || len(p) != len(r) {
+			return false
+		}
+		for i := range r {
+			if !a.bindValue(r[i], p[i], bindings) {
+				return false
+			}
+		}
+		return true
+	case string:
+		p, ok := replayed.(string)
+		return ok && a.bindString(r, p, bindings)
+	default:
+		return recorded == replayed
+	}
+}
+
+func (a *aliases) bindString(recorded, replayed string, bindings map[string]string) bool {
+	if recorded == replayed {
+		return true
+	}
+	recordedTokens := tokenRegexp.FindAllStringIndex(recorded, -1)
+	replayedTokens := tokenRegexp.FindAllStringIndex(replayed, -1)
+	if len(recordedTokens) != len(replayedTokens) ||
+		tokenRegexp.ReplaceAllString(recorded, "") != tokenRegexp.ReplaceAllString(replayed, "") {
+		return false
+	}
+
+	for i := range recordedTokens {
+		r := recorded[recordedTokens[i][0]:recordedTokens[i][1]]
+		p := replayed[replayedTokens[i][0]:replayedTokens[i][1]]
+		if r == p {
+			continue
+		}
+		if !isRandomTokenPair(r, p) {
+			return false
+		}
+		// Every token is bound to a single token in both directions.
+		if bound, ok := bindings[p]; ok && bound != r {
+			return false
+		}
+		if bound, ok := a.replayed[r]; ok && bound != p {
+			return false
+		}
+		if _, ok := a.recorded[p]; ok {
+			return false
+		}
+		for boundReplayed, boundRecorded := range bindings {
+			if boundRecorded == r && boundReplayed != p {
+				return false
+			}
+		}
+		bindings[p] = r
+	}
+	return true
+}
+
+// isRandomTokenPair reports whether the tokens could be generated by acctest: either both are random
+// integers, or both are random strings of the same length.
+func isRandomTokenPair(recorded, replayed string) bool {
+	if len(recorded) < minRandomTokenLength || len(replayed) < minRandomTokenLength {
+		return false
+	}
+	if isDigits(recorded) && isDigits(replayed) {
+		return true
+	}
+	return len(recorded) == len(replayed) && isLowerAlphaNum(recorded) && isLowerAlphaNum(replayed)
+}
+
+func isDigits(s string) bool {
+	return strings.Trim(s, "0123456789") == ""
+}
+
+func isLowerAlphaNum(s string) bool {
+	return strings.Trim(s, "abcdefghijklmnopqrstuvwxyz0123456789") == ""
+}
+
+func replaceTokens(s string, tokens map[string]string) string {
+	if len(tokens) == 0 {
+		return s
+	}
+	return tokenRegexp.ReplaceAllStringFunc(s, func(token string) string {
+		if replacement, ok := tokens[token]; ok {
+			return replacement
+		}
+		return token
+	})
+}
+
+// mapStrings returns a copy of the decoded JSON value with the strings, including the object keys, mapped by f.
+func mapStrings(v interface{}, f func(string) string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			result[f(k)] = mapStrings(e, f)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, e := range v {
+			result[i] = mapStrings(e, f)
+		}
+		return result
+	case string:
+		return f(v)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,71 @@
+// Package replay replays the API calls recorded with logging.NewTraceFileInterceptor by a fake gRPC server,
+// so that the acceptance tests recorded once against the real cloud can be run offline.
+package replay
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
+)
+
+// Cassette is the recorded API calls together with the environment of the recording test run.
+type Cassette struct {
+	Env     map[string]string
+	Entries []logging.TraceEntry
+}
+
+// cassetteLine is a line of the cassette file: either the environment or a traced call.
+type cassetteLine struct {
+	Env map[string]string `json:"env,omitempty"`
+	logging.TraceEntry
+}
+
+// Load reads the cassette recorded to the file.
+func Load(path string) (*Cassette, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cassette: %w", err)
+	}
+	defer f.Close()
+
+	c := &Cassette{Env: make(map[string]string)}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 64*1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		var line cassetteLine
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette %s line %d: %w", path, n, err)
+		}
+		if line.Env != nil {
+			for k, v := range line.Env {
+				c.Env[k] = v
+			}
+			continue
+		}
+		c.Entries = append(c.Entries, line.TraceEntry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read cassette %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// appendEnv appends the environment line to the cassette file.
+func appendEnv(path string, env map[string]string) error {
+	line, err := json.Marshal(map[string]interface{}{"env": env})
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open cassette: %w", err)
+	}
+	defer f.Close()
+
+	_, err = f.Write(append(line, '\n'))
+	return err
+}
@@ -0,0 +1,319 @@
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/endpoint"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
+)
+
+const (
+	apiEndpointListMethod = "/yandex.cloud.endpoint.ApiEndpointService/List"
+	apiEndpointGetMethod  = "/yandex.cloud.endpoint.ApiEndpointService/Get"
+)
+
+// Server is a gRPC server replaying the calls recorded in the cassette.
+//
+// A request is matched to the recorded calls of the same method with the same request, compared after
+// redaction of the sensitive fields. Identical requests get the recorded responses in the recorded order,
+// the last response is repeated once they are exhausted. Unmatched requests fail with the Unimplemented code
+// and the diff from the most similar recorded request.
+//
+// The random names of the test resources differ between the recorded and the replayed runs, so a request
+// differing from a recorded one only in random tokens is matched to it, binding the tokens to each other.
+// The bound tokens are replaced in the following requests and in the replayed responses, see aliases.
+type Server struct {
+	listener   net.Listener
+	grpcServer *grpc.Server
+	env        map[string]string
+
+	mu        sync.Mutex
+	calls     map[string][]*call
+	aliases   *aliases
+	unmatched []string
+}
+
+type call struct {
+	request interface{}
+	entries []logging.TraceEntry
+	next    int
+}
+
+// Start starts the server replaying the cassette on a random local port.
+func Start(c *Cassette) (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen: %w", err)
+	}
+
+	s := &Server{
+		listener: listener,
+		env:      c.Env,
+		calls:    make(map[string][]*call),
+		aliases:  newAliases(),
+	}
+	for _, e := range c.Entries {
+		if err := s.add(e); err != nil {
+			listener.Close()
+			return nil, err
+		}
+	}
+	s.grpcServer = grpc.NewServer(grpc.UnknownServiceHandler(s.handle))
+	go func() {
+		_ = s.grpcServer.Serve(listener)
+	}()
+	return s, nil
+}
+
+func (s *Server) add(e logging.TraceEntry) error {
+	request, err := decodeJSON(e.Request)
+	if err != nil {
+		return fmt.Errorf("failed to decode recorded request of %s: %w", e.Method, err)
+	}
+	for _, c := range s.calls[e.Method] {
+		if reflect.DeepEqual(c.request, request) {
+			c.entries = append(c.entries, e)
+			return nil
+		}
+	}
+	s.calls[e.Method] = append(s.calls[e.Method], &call{request: request, entries: []logging.TraceEntry{e}})
+	return nil
+}
+
+// Endpoint returns the address to be used as the provider `endpoint` together with `plaintext = true`.
+func (s *Server) Endpoint() string {
+	return s.listener.Addr().String()
+}
+
+// Unmatched returns the descriptions of the requests that did not match any recorded call.
+func (s *Server) Unmatched() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.unmatched...)
+}
+
+// Stop stops the server and closes all the connections.
+func (s *Server) Stop() {
+	s.grpcServer.Stop()
+}
+
+func (s *Server) handle(_ interface{}, stream grpc.ServerStream) error {
+	method, ok := grpc.MethodFromServerStream(stream)
+	if !ok {
+		return status.Error(codes.Internal, "failed to get method from stream")
+	}
+	md, err := methodDescriptor(method)
+	if err != nil {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+
+	req, err := newMessage(md.Input())
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err := stream.RecvMsg(req); err != nil {
+		return err
+	}
+
+	resp, err := s.reply(method, md, req)
+	if err != nil {
+		return err
+	}
+	return stream.SendMsg(resp)
+}
+
+func (s *Server) reply(method string, md protoreflect.MethodDescriptor, req proto.Message) (proto.Message, error) {
+	if method == apiEndpointListMethod || method == apiEndpointGetMethod {
+		return s.replyEndpoint(method, req)
+	}
+
+	entry, err := s.match(method, req)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Status.Code != codes.OK.String() {
+		return nil, status.Error(parseCode(entry.Status.Code), entry.Status.Message)
+	}
+
+	resp, err := newMessage(md.Output())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(entry.Response, resp); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode recorded response of %s: %s", method, err)
+	}
+	return resp, nil
+}
+
+// replyEndpoint points every service to the server itself, regardless of the recorded addresses.
+func (s *Server) replyEndpoint(method string, req proto.Message) (proto.Message, error) {
+	ids := map[string]bool{}
+	for _, m := range []string{apiEndpointListMethod, apiEndpointGetMethod} {
+		for _, c := range s.calls[m] {
+			for _, e := range c.entries {
+				for _, id := range endpointIDs(e.Response) {
+					ids[id] = true
+				}
+			}
+		}
+	}
+
+	if method == apiEndpointGetMethod {
+		id := req.(*endpoint.GetApiEndpointRequest).GetApiEndpointId()
+		return &endpoint.ApiEndpoint{Id: id, Address: s.Endpoint()}, nil
+	}
+	resp := &endpoint.ListApiEndpointsResponse{}
+	for id := range ids {
+		resp.Endpoints = append(resp.Endpoints, &endpoint.ApiEndpoint{Id: id, Address: s.Endpoint()})
+	}
+	return resp, nil
+}
+
+func (s *Server) match(method string, req proto.Message) (logging.TraceEntry, error) {
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(logging.RedactSensitive(req))
+	if err != nil {
+		return logging.TraceEntry{}, status.Error(codes.Internal, err.Error())
+	}
+	request, err := decodeJSON(b)
+	if err != nil {
+		return logging.TraceEntry{}, status.Error(codes.Internal, err.Error())
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	request = s.aliases.toRecorded(request)
+	var closest, bound *call
+	var closestDiff string
+	var bindings map[string]string
+	for _, c := range s.calls[method] {
+		if reflect.DeepEqual(c.request, request) {
+			return s.next(c)
+		}
+		if b, ok := s.aliases.bind(c.request, request); ok && (bound == nil || len(b) < len(bindings)) {
+			bound, bindings = c, b
+		}
+		diff := cmp.Diff(c.request, request)
+		if closest == nil || len(diff) < len(closestDiff) {
+			closest, closestDiff = c, diff
+		}
+	}
+	if bound != nil {
+		s.aliases.add(bindings)
+		return s.next(bound)
+	}
+
+	var msg string
+	if closest == nil {
+		msg = fmt.Sprintf("no recorded calls of %s, request:\n%s", method, b)
+	} else {
+		msg = fmt.Sprintf("no recorded call of %s matches the request, diff from the closest recorded request (-recorded +actual):\n%s",
+			method, strings.TrimSpace(closestDiff))
+	}
+	s.unmatched = append(s.unmatched, msg)
+	return logging.TraceEntry{}, status.Error(codes.Unimplemented, msg)
+}
+
+// next returns the next recorded response of the call with the bound tokens replaced. Must be called with s.mu held.
+func (s *Server) next(c *call) (logging.TraceEntry, error) {
+	e := c.entries[c.next]
+	if c.next < len(c.entries)-1 {
+		c.next++
+	}
+	if len(s.aliases.replayed) == 0 || len(e.Response) == 0 {
+		return e, nil
+	}
+
+	response, err := decodeJSON(e.Response)
+	if err != nil {
+		return logging.TraceEntry{}, status.Errorf(codes.Internal, "failed to decode recorded response of %s: %s", e.Method, err)
+	}
+	e.Response, err = json.Marshal(s.aliases.toReplayed(response))
+	if err != nil {
+		return logging.TraceEntry{}, status.Error(codes.Internal, err.Error())
+	}
+	e.Status.Message = replaceTokens(e.Status.Message, s.aliases.replayed)
+	return e, nil
+}
+
+func methodDescriptor(method string) (protoreflect.MethodDescriptor, error) {
+	service, name, ok := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	if !ok {
+		return nil, fmt.Errorf("malformed method name %q", method)
+	}
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, fmt.Errorf("unknown service %s: %w", service, err)
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", service)
+	}
+	md := sd.Methods().ByName(protoreflect.Name(name))
+	if md == nil {
+		return nil, fmt.Errorf("unknown method %s", method)
+	}
+	return md, nil
+}
+
+func newMessage(d protoreflect.MessageDescriptor) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(d.FullName())
+	if err != nil {
+		return nil, fmt.Errorf("unknown message %s: %w", d.FullName(), err)
+	}
+	return mt.New().Interface(), nil
+}
+
+func decodeJSON(b json.RawMessage) (interface{}, error) {
+	if len(b) == 0 {
+		return map[string]interface{}{}, nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func endpointIDs(b json.RawMessage) []string {
+	var resp struct {
+		ID        string `json:"id"`
+		Endpoints []struct {
+			ID string `json:"id"`
+		} `json:"endpoints"`
+	}
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return nil
+	}
+	ids := []string{}
+	if resp.ID != "" {
+		ids = append(ids, resp.ID)
+	}
+	for _, e := range resp.Endpoints {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
+func parseCode(s string) codes.Code {
+	for c := codes.OK; c <= codes.Unauthenticated; c++ {
+		if c.String() == s {
+			return c
+		}
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,210 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/go-sdk/pkg/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
+)
+
+type networkCalls struct {
+	before  *vpc.ListNetworksResponse
+	created *vpc.Network
+	after   *vpc.ListNetworksResponse
+	getErr  error
+}
+
+// callNetworks makes the calls recorded and replayed by the test.
+func callNetworks(t *testing.T, sdk *ycsdk.SDK, folderID string) networkCalls {
+	ctx := context.Background()
+	var calls networkCalls
+	var err error
+
+	calls.before, err = sdk.VPC().Network().List(ctx, &vpc.ListNetworksRequest{FolderId: folderID})
+	require.NoError(t, err)
+
+	op, err := sdk.WrapOperation(sdk.VPC().Network().Create(ctx, &vpc.CreateNetworkRequest{FolderId: folderID, Name: "recorded"}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	resp, err := op.Response()
+	require.NoError(t, err)
+	calls.created = resp.(*vpc.Network)
+
+	calls.after, err = sdk.VPC().Network().List(ctx, &vpc.ListNetworksRequest{FolderId: folderID})
+	require.NoError(t, err)
+
+	_, calls.getErr = sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{NetworkId: "missing"})
+	return calls
+}
+
+func buildSDK(t *testing.T, endpoint string, opts ...grpc.DialOption) *ycsdk.SDK {
+	sdk, err := ycsdk.Build(context.Background(), ycsdk.Config{
+		Credentials: ycsdk.NewIAMTokenCredentials(fakecloud.Token),
+		Endpoint:    endpoint,
+		Plaintext:   true,
+	}, opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sdk.Shutdown(context.Background()) })
+	return sdk
+}
+
+func record(t *testing.T) (string, *fakecloud.Server, networkCalls) {
+	path := filepath.Join(t.TempDir(), "cassette.jsonl")
+
+	fake, err := fakecloud.Start()
+	require.NoError(t, err)
+	t.Cleanup(fake.Stop)
+
+	require.NoError(t, appendEnv(path, map[string]string{"YC_FOLDER_ID": fake.FolderID}))
+	traceInterceptor, err := logging.NewTraceFileInterceptor(path)
+	require.NoError(t, err)
+	sdk := buildSDK(t, fake.Endpoint(), grpc.WithChainUnaryInterceptor(requestid.Interceptor(), traceInterceptor))
+
+	return path, fake, callNetworks(t, sdk, fake.FolderID)
+}
+
+func TestReplay(t *testing.T) {
+	path, fake, recorded := record(t)
+
+	c, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, fake.FolderID, c.Env["YC_FOLDER_ID"])
+
+	s, err := Start(c)
+	require.NoError(t, err)
+	defer s.Stop()
+	env := s.Env()
+	assert.Equal(t, fake.FolderID, env["YC_FOLDER_ID"])
+	assert.Equal(t, s.Endpoint(), env["YC_ENDPOINT"])
+
+	replayed := callNetworks(t, buildSDK(t, s.Endpoint()), fake.FolderID)
+	assert.Empty(t, replayed.before.Networks)
+	assert.Equal(t, recorded.created.Id, replayed.created.Id)
+	require.Len(t, replayed.after.Networks, 1)
+	assert.Equal(t, recorded.created.Id, replayed.after.Networks[0].Id)
+	assert.Equal(t, codes.NotFound, status.Code(replayed.getErr))
+	assert.Equal(t, status.Convert(recorded.getErr).Message(), status.Convert(replayed.getErr).Message())
+	assert.Empty(t, s.Unmatched())
+
+	// The last recorded response is repeated.
+	again, err := buildSDK(t, s.Endpoint()).VPC().Network().List(context.Background(), &vpc.ListNetworksRequest{FolderId: fake.FolderID})
+	require.NoError(t, err)
+	assert.Len(t, again.Networks, 1)
+}
+
+func TestReplayUnmatched(t *testing.T) {
+	path, fake, _ := record(t)
+
+	c, err := Load(path)
+	require.NoError(t, err)
+	s, err := Start(c)
+	require.NoError(t, err)
+	defer s.Stop()
+	sdk := buildSDK(t, s.Endpoint())
+
+	_, err = sdk.VPC().Network().Create(context.Background(), &vpc.CreateNetworkRequest{FolderId: fake.FolderID, Name: "other"})
+	require.Error(t, err)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	assert.Contains(t, err.Error(), `-recorded +actual`)
+	assert.Contains(t, err.Error(), `"recorded"`)
+	assert.Contains(t, err.Error(), `"other"`)
+
+	_, err = sdk.VPC().Subnet().List(context.Background(), &vpc.ListSubnetsRequest{FolderId: fake.FolderID})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no recorded calls of /yandex.cloud.vpc.v1.SubnetService/List")
+
+	assert.Len(t, s.Unmatched(), 2)
+}
+
+// callNamedNetworks makes the calls with the random names generated by the test.
+func callNamedNetworks(t *testing.T, sdk *ycsdk.SDK, folderID string, names ...string) []*vpc.Network {
+	ctx := context.Background()
+	var networks []*vpc.Network
+	for _, name := range names {
+		op, err := sdk.WrapOperation(sdk.VPC().Network().Create(ctx, &vpc.CreateNetworkRequest{
+			FolderId:    folderID,
+			Name:        name,
+			Description: "network " + name,
+		}))
+		require.NoError(t, err)
+		require.NoError(t, op.Wait(ctx))
+	}
+	for _, name := range names {
+		resp, err := sdk.VPC().Network().List(ctx, &vpc.ListNetworksRequest{FolderId: folderID, Filter: fmt.Sprintf("name = %q", name)})
+		require.NoError(t, err)
+		require.Len(t, resp.Networks, 1)
+		networks = append(networks, resp.Networks[0])
+	}
+	return networks
+}
+
+func TestReplayRandomNames(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.jsonl")
+	fake, err := fakecloud.Start()
+	require.NoError(t, err)
+	t.Cleanup(fake.Stop)
+	traceInterceptor, err := logging.NewTraceFileInterceptor(path)
+	require.NoError(t, err)
+	sdk := buildSDK(t, fake.Endpoint(), grpc.WithChainUnaryInterceptor(requestid.Interceptor(), traceInterceptor))
+	recorded := callNamedNetworks(t, sdk, fake.FolderID, "tf-network-5577006791947779410", "tf-network-kbaxjzpqwe")
+
+	c, err := Load(path)
+	require.NoError(t, err)
+	s, err := Start(c)
+	require.NoError(t, err)
+	defer s.Stop()
+
+	replayed := callNamedNetworks(t, buildSDK(t, s.Endpoint()), fake.FolderID, "tf-network-8674665223082153551", "tf-network-mzvdhxtrwa")
+	assert.Empty(t, s.Unmatched())
+	require.Len(t, replayed, 2)
+	assert.Equal(t, recorded[0].Id, replayed[0].Id)
+	assert.Equal(t, "tf-network-8674665223082153551", replayed[0].Name)
+	assert.Equal(t, "network tf-network-8674665223082153551", replayed[0].Description)
+	assert.Equal(t, recorded[1].Id, replayed[1].Id)
+	assert.Equal(t, "tf-network-mzvdhxtrwa", replayed[1].Name)
+}
+
+func TestAliasesBind(t *testing.T) {
+	testCases := []struct {
+		name     string
+		recorded string
+		replayed string
+		ok       bool
+	}{
+		{"random integers", "tf-test-5577006791947779410", "tf-test-867466522308215", true},
+		{"random strings of the same length", "tf-test-kbaxjzpqwe", "tf-test-mzvdhxtrwa", true},
+		{"random strings of different length", "recorded", "other", false},
+		{"different prefixes", "tf-network-5577006791947779410", "tf-subnet-5577006791947779411", false},
+		{"different separators", "tf-test-5577006791947779410", "tf_test_8674665223082153551", false},
+		{"short tokens", "tf-a-123", "tf-a-456", false},
+		{"upper case tokens", "tf-test-ABCDEFGH", "tf-test-HGFEDCBA", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ok := newAliases().bind(tc.recorded, tc.replayed)
+			assert.Equal(t, tc.ok, ok)
+		})
+	}
+
+	// A token once bound is not bound to another one.
+	a := newAliases()
+	bindings, ok := a.bind("tf-5577006791947779410", "tf-8674665223082153551")
+	require.True(t, ok)
+	a.add(bindings)
+	_, ok = a.bind("tf-5577006791947779410", "tf-6129484611666145821")
+	assert.False(t, ok)
+	assert.Equal(t, "name tf-5577006791947779410", a.toRecorded("name tf-8674665223082153551"))
+	assert.Equal(t, "name tf-8674665223082153551", a.toReplayed("name tf-5577006791947779410"))
+}
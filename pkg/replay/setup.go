@@ -0,0 +1,104 @@
+package replay
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+const (
+	// RecordEnvVar is the cassette file to record the API calls of the acceptance tests to, see StartIfEnabled.
+	RecordEnvVar = "YC_API_RECORD_FILE"
+	// ReplayEnvVar is the cassette file to replay the API calls of the acceptance tests from, see StartIfEnabled.
+	ReplayEnvVar = "YC_API_REPLAY_FILE"
+
+	// traceEnvVar is the environment variable of the provider `api_trace_file` setting.
+	traceEnvVar = "YC_API_TRACE_FILE"
+)
+
+// recordedEnv are the environment variables of the recording run the acceptance tests depend on.
+var recordedEnv = []string{
+	"YC_CLOUD_ID",
+	"YC_FOLDER_ID",
+	"YC_ORGANIZATION_ID",
+	"YC_ZONE",
+	"YC_LOGIN",
+	"YC_LOGIN_2",
+	"YC_STORAGE_ENDPOINT_URL",
+	"YC_MESSAGE_QUEUE_ENDPOINT",
+}
+
+// StartIfEnabled sets up recording or replaying of the API calls of the acceptance tests.
+//
+// If RecordEnvVar is set, the cassette file is truncated, the test environment is saved to it,
+// and the provider is configured to trace the API calls to it.
+//
+// If ReplayEnvVar is set, the server replaying the cassette is started and the variables
+// returned by Server.Env are exported to the process environment, so that the acceptance tests
+// and the provider under test use the server. It returns nil server otherwise.
+func StartIfEnabled() (*Server, error) {
+	if path := os.Getenv(RecordEnvVar); path != "" {
+		return nil, startRecording(path)
+	}
+
+	path := os.Getenv(ReplayEnvVar)
+	if path == "" {
+		return nil, nil
+	}
+
+	c, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+	s, err := Start(c)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range s.Env() {
+		if err := os.Setenv(k, v); err != nil {
+			s.Stop()
+			return nil, fmt.Errorf("failed to set %s: %w", k, err)
+		}
+	}
+	for _, k := range []string{"YC_SERVICE_ACCOUNT_KEY_FILE", traceEnvVar} {
+		if err := os.Unsetenv(k); err != nil {
+			s.Stop()
+			return nil, fmt.Errorf("failed to unset %s: %w", k, err)
+		}
+	}
+	return s, nil
+}
+
+func startRecording(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to truncate cassette: %w", err)
+	}
+
+	env := make(map[string]string)
+	for _, k := range recordedEnv {
+		if v, ok := os.LookupEnv(k); ok {
+			env[k] = v
+		}
+	}
+	if err := appendEnv(path, env); err != nil {
+		return err
+	}
+	if err := os.Setenv(traceEnvVar, path); err != nil {
+		return fmt.Errorf("failed to set %s: %w", traceEnvVar, err)
+	}
+	return nil
+}
+
+// Env returns environment variables pointing the provider and the acceptance tests to the server,
+// including the environment of the recording run saved to the cassette.
+func (s *Server) Env() map[string]string {
+	env := make(map[string]string, len(s.env)+3)
+	for k, v := range s.env {
+		env[k] = v
+	}
+	env["YC_ENDPOINT"] = s.Endpoint()
+	env["YC_PLAINTEXT"] = "true"
+	env["YC_TOKEN"] = fakecloud.Token
+	return env
+}
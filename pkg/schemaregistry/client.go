@@ -0,0 +1,236 @@
+// Package schemaregistry implements a client of the Confluent-compatible Schema Registry REST API
+// served by the Managed Service for Apache Kafka® clusters with the schema registry enabled.
+package schemaregistry
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	contentType = "application/vnd.schemaregistry.v1+json"
+
+	// Error codes of the Schema Registry API returned along with the 404 status.
+	errorCodeSubjectNotFound = 40401
+	errorCodeVersionNotFound = 40402
+)
+
+// Compatibility levels supported by the schema registry.
+var CompatibilityLevels = []string{
+	"BACKWARD",
+	"BACKWARD_TRANSITIVE",
+	"FORWARD",
+	"FORWARD_TRANSITIVE",
+	"FULL",
+	"FULL_TRANSITIVE",
+	"NONE",
+}
+
+// Schema types supported by the schema registry. AVRO is the default one.
+var SchemaTypes = []string{
+	"AVRO",
+	"JSON",
+	"PROTOBUF",
+}
+
+// Client calls the Schema Registry API on behalf of a Kafka user.
+type Client struct {
+	Endpoint string
+	Username string
+	Password string
+
+	client *http.Client
+}
+
+// New returns the client of the schema registry at the endpoint, e.g. https://<broker host FQDN>:443.
+// If caCertificate is not empty, the server certificate is verified against it instead of the system pool.
+func New(endpoint, username, password string, caCertificate []byte) (*Client, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("schema registry endpoint should be specified")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(caCertificate) != 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCertificate) {
+			return nil, fmt.Errorf("failed to parse schema registry CA certificate")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+
+	return &Client{
+		Endpoint: strings.TrimSuffix(endpoint, "/"),
+		Username: username,
+		Password: password,
+		client:   &http.Client{Timeout: time.Minute, Transport: transport},
+	}, nil
+}
+
+// Reference is a reference to a schema registered under another subject.
+type Reference struct {
+	Name    string `json:"name"`
+	Subject string `json:"subject"`
+	Version int    `json:"version"`
+}
+
+// Schema is a version of the schema registered under a subject.
+type Schema struct {
+	Subject    string      `json:"subject,omitempty"`
+	ID         int         `json:"id,omitempty"`
+	Version    int         `json:"version,omitempty"`
+	SchemaType string      `json:"schemaType,omitempty"`
+	Schema     string      `json:"schema"`
+	References []Reference `json:"references,omitempty"`
+}
+
+// Error is an error response of the Schema Registry API.
+type Error struct {
+	StatusCode int    `json:"-"`
+	ErrorCode  int    `json:"error_code"`
+	Message    string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("schema registry request failed with status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("schema registry request failed with status %d: %s (error code %d)", e.StatusCode, e.Message, e.ErrorCode)
+}
+
+// IsNotFound reports whether err means that the subject or its version does not exist.
+func IsNotFound(err error) bool {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.ErrorCode == errorCodeSubjectNotFound || apiErr.ErrorCode == errorCodeVersionNotFound ||
+		(apiErr.ErrorCode == 0 && apiErr.StatusCode == http.StatusNotFound)
+}
+
+// RegisterSchema registers the schema under the subject and returns its global ID.
+// Registering the schema which is already the latest version of the subject does not create a new version.
+func (c *Client) RegisterSchema(ctx context.Context, subject string, schema *Schema) (int, error) {
+	request := &Schema{
+		Schema:     schema.Schema,
+		References: schema.References,
+	}
+	// AVRO is the default type and is omitted for compatibility with older registries.
+	if schema.SchemaType != "AVRO" {
+		request.SchemaType = schema.SchemaType
+	}
+
+	var response struct {
+		ID int `json:"id"`
+	}
+	if err := c.do(ctx, http.MethodPost, subjectPath(subject, "versions"), nil, request, &response); err != nil {
+		return 0, err
+	}
+	return response.ID, nil
+}
+
+// GetLatestSchema returns the latest version of the schema registered under the subject.
+func (c *Client) GetLatestSchema(ctx context.Context, subject string) (*Schema, error) {
+	schema := &Schema{}
+	if err := c.do(ctx, http.MethodGet, subjectPath(subject, "versions", "latest"), nil, nil, schema); err != nil {
+		return nil, err
+	}
+	if schema.SchemaType == "" {
+		schema.SchemaType = "AVRO"
+	}
+	return schema, nil
+}
+
+// GetCompatibilityLevel returns the compatibility level of the subject,
+// falling back to the global one if it is not set for the subject.
+func (c *Client) GetCompatibilityLevel(ctx context.Context, subject string) (string, error) {
+	var response struct {
+		CompatibilityLevel string `json:"compatibilityLevel"`
+	}
+	query := url.Values{"defaultToGlobal": {"true"}}
+	if err := c.do(ctx, http.MethodGet, "/config/"+url.PathEscape(subject), query, nil, &response); err != nil {
+		return "", err
+	}
+	return response.CompatibilityLevel, nil
+}
+
+// SetCompatibilityLevel sets the compatibility level of the subject.
+func (c *Client) SetCompatibilityLevel(ctx context.Context, subject, level string) error {
+	request := map[string]string{"compatibility": level}
+	return c.do(ctx, http.MethodPut, "/config/"+url.PathEscape(subject), nil, request, nil)
+}
+
+// DeleteSubject deletes all versions of the schema registered under the subject.
+// Soft deleted subjects can be registered again with the same schema IDs, permanently deleted ones can not be restored.
+// The subject should be soft deleted before it is deleted permanently.
+func (c *Client) DeleteSubject(ctx context.Context, subject string, permanent bool) error {
+	var query url.Values
+	if permanent {
+		query = url.Values{"permanent": {"true"}}
+	}
+	return c.do(ctx, http.MethodDelete, subjectPath(subject), query, nil, nil)
+}
+
+func subjectPath(subject string, elems ...string) string {
+	return strings.Join(append([]string{"/subjects", url.PathEscape(subject)}, elems...), "/")
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, request, response interface{}) error {
+	var body io.Reader
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return fmt.Errorf("failed to marshal schema registry request: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	u := c.Endpoint + path
+	if len(query) != 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return fmt.Errorf("failed to create schema registry request: %w", err)
+	}
+	req.Header.Set("Accept", contentType)
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.Username != "" {
+		req.SetBasicAuth(c.Username, c.Password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call schema registry: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("failed to read schema registry response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := &Error{StatusCode: resp.StatusCode}
+		_ = json.Unmarshal(data, apiErr)
+		return apiErr
+	}
+	if response == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, response); err != nil {
+		return fmt.Errorf("failed to parse schema registry response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package schemaregistry_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakeschemaregistry"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/schemaregistry"
+)
+
+const (
+	testSubject  = "orders-value"
+	testSchemaV1 = `{"type":"record","name":"Order","fields":[{"name":"id","type":"long"}]}`
+	testSchemaV2 = `{"type":"record","name":"Order","fields":[{"name":"id","type":"long"},{"name":"note","type":"string","default":""}]}`
+)
+
+func startServer(t *testing.T) (*fakeschemaregistry.Server, *schemaregistry.Client) {
+	server := fakeschemaregistry.Start()
+	t.Cleanup(server.Close)
+
+	client, err := schemaregistry.New(server.URL, fakeschemaregistry.Username, fakeschemaregistry.Password, nil)
+	require.NoError(t, err)
+	return server, client
+}
+
+func TestRegisterSchema(t *testing.T) {
+	_, client := startServer(t)
+	ctx := context.Background()
+
+	id, err := client.RegisterSchema(ctx, testSubject, &schemaregistry.Schema{SchemaType: "AVRO", Schema: testSchemaV1})
+	require.NoError(t, err)
+
+	// The same schema does not create a new version.
+	sameID, err := client.RegisterSchema(ctx, testSubject, &schemaregistry.Schema{SchemaType: "AVRO", Schema: testSchemaV1})
+	require.NoError(t, err)
+	assert.Equal(t, id, sameID)
+
+	latest, err := client.GetLatestSchema(ctx, testSubject)
+	require.NoError(t, err)
+	assert.Equal(t, &schemaregistry.Schema{
+		Subject:    testSubject,
+		ID:         id,
+		Version:    1,
+		SchemaType: "AVRO",
+		Schema:     testSchemaV1,
+	}, latest)
+
+	newID, err := client.RegisterSchema(ctx, testSubject, &schemaregistry.Schema{SchemaType: "AVRO", Schema: testSchemaV2})
+	require.NoError(t, err)
+	assert.NotEqual(t, id, newID)
+
+	latest, err = client.GetLatestSchema(ctx, testSubject)
+	require.NoError(t, err)
+	assert.Equal(t, 2, latest.Version)
+	assert.Equal(t, testSchemaV2, latest.Schema)
+}
+
+func TestRegisterSchemaWithReferences(t *testing.T) {
+	server, client := startServer(t)
+	ctx := context.Background()
+
+	_, err := client.RegisterSchema(ctx, "common.proto", &schemaregistry.Schema{
+		SchemaType: "PROTOBUF",
+		Schema:     `syntax = "proto3"; message Money { int64 units = 1; }`,
+	})
+	require.NoError(t, err)
+
+	references := []schemaregistry.Reference{{Name: "common.proto", Subject: "common.proto", Version: 1}}
+	_, err = client.RegisterSchema(ctx, testSubject, &schemaregistry.Schema{
+		SchemaType: "PROTOBUF",
+		Schema:     `syntax = "proto3"; import "common.proto"; message Order { Money total = 1; }`,
+		References: references,
+	})
+	require.NoError(t, err)
+
+	latest, ok := server.LatestSchema(testSubject)
+	require.True(t, ok)
+	assert.Equal(t, "PROTOBUF", latest.SchemaType)
+	assert.Equal(t, references, latest.References)
+}
+
+func TestCompatibilityLevel(t *testing.T) {
+	server, client := startServer(t)
+	ctx := context.Background()
+
+	level, err := client.GetCompatibilityLevel(ctx, testSubject)
+	require.NoError(t, err)
+	assert.Equal(t, fakeschemaregistry.GlobalCompatibilityLevel, level)
+
+	require.NoError(t, client.SetCompatibilityLevel(ctx, testSubject, "FULL_TRANSITIVE"))
+	assert.Equal(t, "FULL_TRANSITIVE", server.CompatibilityLevel(testSubject))
+
+	level, err = client.GetCompatibilityLevel(ctx, testSubject)
+	require.NoError(t, err)
+	assert.Equal(t, "FULL_TRANSITIVE", level)
+
+	err = client.SetCompatibilityLevel(ctx, testSubject, "SOMETIMES")
+	require.Error(t, err)
+	assert.False(t, schemaregistry.IsNotFound(err))
+}
+
+func TestDeleteSubject(t *testing.T) {
+	server, client := startServer(t)
+	ctx := context.Background()
+
+	_, err := client.RegisterSchema(ctx, testSubject, &schemaregistry.Schema{SchemaType: "AVRO", Schema: testSchemaV1})
+	require.NoError(t, err)
+
+	// Permanent deletion requires soft deletion first.
+	require.Error(t, client.DeleteSubject(ctx, testSubject, true))
+
+	require.NoError(t, client.DeleteSubject(ctx, testSubject, false))
+	_, err = client.GetLatestSchema(ctx, testSubject)
+	assert.True(t, schemaregistry.IsNotFound(err), "unexpected error: %v", err)
+
+	require.NoError(t, client.DeleteSubject(ctx, testSubject, true))
+	_, ok := server.LatestSchema(testSubject)
+	assert.False(t, ok)
+
+	err = client.DeleteSubject(ctx, testSubject, false)
+	assert.True(t, schemaregistry.IsNotFound(err), "unexpected error: %v", err)
+}
+
+func TestUnauthorized(t *testing.T) {
+	server, _ := startServer(t)
+
+	client, err := schemaregistry.New(server.URL, fakeschemaregistry.Username, "wrong", nil)
+	require.NoError(t, err)
+
+	_, err = client.GetLatestSchema(context.Background(), testSubject)
+	var apiErr *schemaregistry.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 401, apiErr.StatusCode)
+	assert.False(t, schemaregistry.IsNotFound(err))
+}
+
+func TestNew(t *testing.T) {
+	_, err := schemaregistry.New("", "user", "password", nil)
+	assert.Error(t, err)
+
+	_, err = schemaregistry.New("https://localhost:443", "user", "password", []byte("not a certificate"))
+	assert.Error(t, err)
+}
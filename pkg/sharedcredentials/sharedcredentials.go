@@ -0,0 +1,226 @@
+// Package sharedcredentials reads the provider settings from a profile of the shared credentials file.
+//
+// Two formats are supported. The INI-like format has `[profile]` sections of `key = value` lines
+// with the keys named as the provider arguments. The yc CLI `config.yaml` format has the profiles
+// under the `profiles` key, with the keys named as the yc CLI properties.
+package sharedcredentials
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/yandex-cloud/go-sdk/iamkey"
+	"gopkg.in/yaml.v2"
+)
+
+type Profile = string
+type RawCredentials = map[string]string
+
+// DefaultProfile is the profile used if the profile is not selected and the file does not set the current one.
+const DefaultProfile = "default"
+
+// A Provider retrieves credentials for the selected profile from the given file.
+type Provider struct {
+	// Path to the shared credentials file.
+	Filename string
+
+	// YC Profile to extract credentials from the shared credentials file.
+	// If empty, the current profile of the yc CLI config or DefaultProfile is used.
+	Profile string
+}
+
+// Credentials are the provider settings of the profile. Empty values are not set in the profile.
+type Credentials struct {
+	Token string
+	// ServiceAccountKeyFileOrContent is a path to the service account key file in the INI-like format,
+	// or the key JSON in the yc CLI format, which keeps the key inline.
+	ServiceAccountKeyFileOrContent string
+
+	CloudID  string
+	FolderID string
+	Zone     string
+	Endpoint string
+
+	StorageAccessKey string
+	StorageSecretKey string
+
+	YMQAccessKey string
+	YMQSecretKey string
+}
+
+// keys are the keys of the INI-like format.
+var keys = map[string]func(c *Credentials) *string{
+	"token":                    func(c *Credentials) *string { return &c.Token },
+	"service_account_key_file": func(c *Credentials) *string { return &c.ServiceAccountKeyFileOrContent },
+	"cloud_id":                 func(c *Credentials) *string { return &c.CloudID },
+	"folder_id":                func(c *Credentials) *string { return &c.FolderID },
+	"zone":                     func(c *Credentials) *string { return &c.Zone },
+	"endpoint":                 func(c *Credentials) *string { return &c.Endpoint },
+	"storage_access_key":       func(c *Credentials) *string { return &c.StorageAccessKey },
+	"storage_secret_key":       func(c *Credentials) *string { return &c.StorageSecretKey },
+	"ymq_access_key":           func(c *Credentials) *string { return &c.YMQAccessKey },
+	"ymq_secret_key":           func(c *Credentials) *string { return &c.YMQSecretKey },
+}
+
+// Retrieve reads and extracts the credentials for the selected profile from the given file.
+// Files with `.yaml` or `.yml` extension are read in the yc CLI format.
+func (p *Provider) Retrieve() (*Credentials, error) {
+	switch strings.ToLower(filepath.Ext(p.Filename)) {
+	case ".yaml", ".yml":
+		return p.retrieveCLIConfig()
+	}
+
+	rawCredentialsByProfiles, err := parse(p.Filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse shared credentials file, error: \"%w\"", err)
+	}
+
+	profile := p.Profile
+	if profile == "" {
+		profile = DefaultProfile
+	}
+
+	rawCredentials, ok := rawCredentialsByProfiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("not found shared credentials for `%v` profile", profile)
+	}
+
+	credentials := Credentials{}
+	for key, field := range keys {
+		if val, ok := rawCredentials[key]; ok {
+			*field(&credentials) = val
+		}
+	}
+
+	return &credentials, nil
+}
+
+func (p *Credentials) HasStorageAccessKeys() bool {
+	return p.StorageAccessKey != "" && p.StorageSecretKey != ""
+}
+
+func parse(filename string) (map[Profile]RawCredentials, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	credentialsByProfiles, err := parseLines(scanner)
+	if err != nil {
+		return nil, err
+	}
+	if scanner.Err() != nil {
+		return nil, scanner.Err()
+	}
+
+	return credentialsByProfiles, nil
+}
+
+func parseLines(scanner *bufio.Scanner) (map[Profile]RawCredentials, error) {
+	credentialsByProfiles := make(map[Profile]RawCredentials)
+
+	profileRegex := regexp.MustCompile(`^\[(.+)\]$`)
+	keyValuePairRegex := regexp.MustCompile(`^([ \w_]+)=(.+)$`)
+
+	var currentProfile string
+	var currentProfileCredentials RawCredentials
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		if profileRegex.MatchString(line) {
+			if currentProfile != "" {
+				credentialsByProfiles[currentProfile] = currentProfileCredentials
+			}
+
+			currentProfile = profileRegex.FindStringSubmatch(line)[1]
+			currentProfileCredentials = make(map[string]string)
+		} else if keyValuePairRegex.MatchString(line) {
+			keyValue := keyValuePairRegex.FindStringSubmatch(line)
+			key := strings.TrimSpace(keyValue[1])
+			value := strings.TrimSpace(keyValue[2])
+			if currentProfile == "" {
+				return nil, fmt.Errorf("key `%v` does not have a profile", key)
+			}
+			if _, exists := currentProfileCredentials[key]; exists {
+				return nil, fmt.Errorf("key `%v` has multiple values", key)
+			}
+			currentProfileCredentials[key] = value
+		} else {
+			return nil, fmt.Errorf("unsupported shared credentials file format, line `%v` does not match "+
+				"either `[{profile}]` or `{key}={value}` patterns", line)
+		}
+	}
+
+	if currentProfile != "" {
+		credentialsByProfiles[currentProfile] = currentProfileCredentials
+	}
+
+	return credentialsByProfiles, nil
+}
+
+// cliConfig is the yc CLI config.yaml.
+type cliConfig struct {
+	Current  string                `yaml:"current"`
+	Profiles map[string]cliProfile `yaml:"profiles"`
+}
+
+type cliProfile struct {
+	Token              string      `yaml:"token"`
+	ServiceAccountKey  *iamkey.Key `yaml:"service-account-key"`
+	CloudID            string      `yaml:"cloud-id"`
+	FolderID           string      `yaml:"folder-id"`
+	ComputeDefaultZone string      `yaml:"compute-default-zone"`
+	Endpoint           string      `yaml:"endpoint"`
+}
+
+func (p *Provider) retrieveCLIConfig() (*Credentials, error) {
+	data, err := os.ReadFile(p.Filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read yc CLI config, error: \"%w\"", err)
+	}
+
+	var config cliConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse yc CLI config, error: \"%w\"", err)
+	}
+
+	// The yc CLI uses the current profile unless another one is selected.
+	name := p.Profile
+	if name == "" {
+		name = config.Current
+	}
+	if name == "" {
+		name = DefaultProfile
+	}
+
+	profile, ok := config.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("not found shared credentials for `%v` profile", name)
+	}
+
+	credentials := Credentials{
+		Token:    profile.Token,
+		CloudID:  profile.CloudID,
+		FolderID: profile.FolderID,
+		Zone:     profile.ComputeDefaultZone,
+		Endpoint: profile.Endpoint,
+	}
+	if profile.ServiceAccountKey != nil {
+		key, err := profile.ServiceAccountKey.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read service account key of `%v` profile, error: \"%w\"", name, err)
+		}
+		credentials.ServiceAccountKeyFileOrContent = string(key)
+	}
+
+	return &credentials, nil
+}
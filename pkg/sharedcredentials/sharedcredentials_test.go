@@ -0,0 +1,206 @@
+package sharedcredentials
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-sdk/iamkey"
+)
+
+func TestRetrieve(t *testing.T) {
+	profile := "prod-profile"
+	cases := []struct {
+		name                string
+		fileContent         string
+		expectedCredentials *Credentials
+		expectedError       string
+	}{
+		{
+			name:          "empty file content",
+			fileContent:   "",
+			expectedError: "not found shared credentials for `prod-profile` profile",
+		},
+		{
+			name:          "unsupported shared credentials file format",
+			fileContent:   "data",
+			expectedError: "unsupported shared credentials file format, line `data` does not match",
+		},
+		{
+			name:                "valid shared credentials file with single profile",
+			fileContent:         "[prod-profile]\nstorage_access_key=access-key\nstorage_secret_key=secret-key",
+			expectedCredentials: &Credentials{StorageAccessKey: "access-key", StorageSecretKey: "secret-key"},
+		},
+		{
+			name: "valid shared credentials file with multiple profiles",
+			fileContent: "[dev-profile]\nstorage_access_key=dev-access-key\nstorage_secret_key=dev-secret-key\n\n" +
+				"[prod-profile]\nstorage_access_key=prod-access-key\nstorage_secret_key=prod-secret-key",
+			expectedCredentials: &Credentials{StorageAccessKey: "prod-access-key", StorageSecretKey: "prod-secret-key"},
+		},
+		{
+			name:          "credentials without profile",
+			fileContent:   "storage_access_key=dev-access-key\nstorage_secret_key=dev-secret-key",
+			expectedError: "key `storage_access_key` does not have a profile",
+		},
+		{
+			name:          "duplicate credentials",
+			fileContent:   "[prod-profile]\nstorage_access_key=dev-access-key\nstorage_access_key=dev-access-key",
+			expectedError: "key `storage_access_key` has multiple values",
+		},
+		{
+			name:                "multiple equality symbols",
+			fileContent:         "[prod-profile]\nstorage_access_key=access-key===\nstorage_secret_key=secret-key===",
+			expectedCredentials: &Credentials{StorageAccessKey: "access-key===", StorageSecretKey: "secret-key==="},
+		},
+		{
+			name: "extra credentials",
+			fileContent: "[prod-profile]\nstorage_access_key=access-key\nstorage_secret_key=secret-key\n" +
+				"extra_key=extra-key",
+			expectedCredentials: &Credentials{StorageAccessKey: "access-key", StorageSecretKey: "secret-key"},
+		},
+		{
+			name:                "partial credentials",
+			fileContent:         "[prod-profile]\nstorage_access_key=access-key",
+			expectedCredentials: &Credentials{StorageAccessKey: "access-key", StorageSecretKey: ""},
+		},
+		{
+			name:                "no credentials",
+			fileContent:         "[prod-profile]",
+			expectedCredentials: &Credentials{StorageAccessKey: "", StorageSecretKey: ""},
+		},
+		{
+			name:          "no given profile",
+			fileContent:   "[testing-profile]\nstorage_access_key=access-key\nstorage_secret_key=secret-key",
+			expectedError: "not found shared credentials for `prod-profile` profile",
+		},
+		{
+			name: "all settings",
+			fileContent: "[prod-profile]\ntoken=oauth-token\nservice_account_key_file=/keys/sa.json\n" +
+				"cloud_id=cloud\nfolder_id=folder\nzone=ru-central1-b\nendpoint=api.example.net:443\n" +
+				"storage_access_key=access-key\nstorage_secret_key=secret-key\n" +
+				"ymq_access_key=ymq-access-key\nymq_secret_key=ymq-secret-key",
+			expectedCredentials: &Credentials{
+				Token:                          "oauth-token",
+				ServiceAccountKeyFileOrContent: "/keys/sa.json",
+				CloudID:                        "cloud",
+				FolderID:                       "folder",
+				Zone:                           "ru-central1-b",
+				Endpoint:                       "api.example.net:443",
+				StorageAccessKey:               "access-key",
+				StorageSecretKey:               "secret-key",
+				YMQAccessKey:                   "ymq-access-key",
+				YMQSecretKey:                   "ymq-secret-key",
+			},
+		},
+		{
+			name:                "trim key/value empty spaces",
+			fileContent:         "[prod-profile]\n storage_access_key  = access-key  \n storage_secret_key  = secret-key  ",
+			expectedCredentials: &Credentials{StorageAccessKey: "access-key", StorageSecretKey: "secret-key"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			filename, err := writeFile(tc.fileContent)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.Remove(filename)
+			sharedCredentialsFileProvider := Provider{filename, profile}
+
+			result, err := sharedCredentialsFileProvider.Retrieve()
+			if err != nil {
+				if tc.expectedError == "" {
+					t.Errorf("Unexpected error: %#v", err.Error())
+				} else if !strings.Contains(err.Error(), tc.expectedError) {
+					t.Errorf("Expected `%v` error, but got `%v`", tc.expectedError, err.Error())
+				}
+			} else {
+				assert.Equal(t, tc.expectedCredentials, result)
+			}
+		})
+	}
+}
+
+func writeFile(data string) (string, error) {
+	tmpFile, err := os.CreateTemp("", "shared-credentials-file-test")
+
+	if err != nil {
+		return "", err
+	}
+
+	err = os.WriteFile(tmpFile.Name(), []byte(data), 0777)
+	if err != nil {
+		return "", err
+	}
+
+	return tmpFile.Name(), err
+}
+
+func TestRetrieveCLIConfig(t *testing.T) {
+	const config = `current: prod-profile
+profiles:
+  default:
+    token: default-token
+  prod-profile:
+    service-account-key:
+      id: ajekeyid
+      service_account_id: ajeserviceaccount
+      created_at: "2024-01-01T00:00:00Z"
+      key_algorithm: RSA_2048
+      public_key: public
+      private_key: private
+    cloud-id: cloud
+    folder-id: folder
+    compute-default-zone: ru-central1-d
+    endpoint: api.example.net:443
+`
+	filename := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(filename, []byte(config), 0600))
+
+	result, err := (&Provider{filename, "prod-profile"}).Retrieve()
+	require.NoError(t, err)
+	assert.Equal(t, "cloud", result.CloudID)
+	assert.Equal(t, "folder", result.FolderID)
+	assert.Equal(t, "ru-central1-d", result.Zone)
+	assert.Equal(t, "api.example.net:443", result.Endpoint)
+	assert.Empty(t, result.Token)
+
+	key := &iamkey.Key{}
+	require.NoError(t, json.Unmarshal([]byte(result.ServiceAccountKeyFileOrContent), key))
+	assert.Equal(t, "ajekeyid", key.GetId())
+	assert.Equal(t, "ajeserviceaccount", key.GetServiceAccountId())
+	assert.Equal(t, "private", key.GetPrivateKey())
+
+	result, err = (&Provider{filename, "default"}).Retrieve()
+	require.NoError(t, err)
+	assert.Equal(t, &Credentials{Token: "default-token"}, result)
+
+	// The current profile is used if the profile is not selected.
+	result, err = (&Provider{filename, ""}).Retrieve()
+	require.NoError(t, err)
+	assert.Equal(t, "cloud", result.CloudID)
+	assert.Equal(t, "folder", result.FolderID)
+
+	_, err = (&Provider{filename, "missing"}).Retrieve()
+	assert.EqualError(t, err, "not found shared credentials for `missing` profile")
+}
+
+func TestRetrieveCLIConfigWithoutCurrentProfile(t *testing.T) {
+	const config = `profiles:
+  default:
+    token: default-token
+  prod-profile:
+    token: prod-token
+`
+	filename := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(filename, []byte(config), 0600))
+
+	result, err := (&Provider{filename, ""}).Retrieve()
+	require.NoError(t, err)
+	assert.Equal(t, &Credentials{Token: "default-token"}, result)
+}
@@ -0,0 +1,244 @@
+// Package waiter implements waiting for Yandex Cloud operations shared by the SDKv2 and the framework providers.
+//
+// Wait reports the progress of long-running operations through tflog and, if given a private state,
+// stores the ID of the operation in it while waiting. If Terraform is interrupted before the operation
+// is done, the ID stays in the private state saved by Terraform, so that the next run can resume waiting
+// for the same operation with Resume instead of starting a new one.
+package waiter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	sdkoperation "github.com/yandex-cloud/go-sdk/operation"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// PrivateStateKey is the key of the private state holding the in-flight operation.
+const PrivateStateKey = "operation"
+
+var (
+	// ProgressInterval is the interval between progress reports of a running operation.
+	ProgressInterval = 30 * time.Second
+	// PollInterval is the interval between polls of a running operation.
+	PollInterval = sdkoperation.DefaultPollInterval
+)
+
+// PrivateState is the resource private state, implemented by the framework *privatestate.ProviderData
+// available as Private in the resource requests and responses.
+type PrivateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+type pendingOperation struct {
+	ID string `json:"id"`
+}
+
+// AttributeState is the PrivateState keeping the operation ID in a string attribute of the resource,
+// e.g. a computed attribute of the SDKv2 resources, which have no private state available.
+type AttributeState struct {
+	Get func() string
+	Set func(id string) error
+}
+
+func (s AttributeState) GetKey(_ context.Context, _ string) ([]byte, diag.Diagnostics) {
+	id := s.Get()
+	if id == "" {
+		return nil, nil
+	}
+	value, err := json.Marshal(pendingOperation{ID: id})
+	if err != nil {
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Failed to encode pending operation", err.Error())}
+	}
+	return value, nil
+}
+
+func (s AttributeState) SetKey(_ context.Context, _ string, value []byte) diag.Diagnostics {
+	var pending pendingOperation
+	if len(value) != 0 {
+		if err := json.Unmarshal(value, &pending); err != nil {
+			return diag.Diagnostics{diag.NewErrorDiagnostic("Failed to decode pending operation", err.Error())}
+		}
+	}
+	if err := s.Set(pending.ID); err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Failed to set pending operation", err.Error())}
+	}
+	return nil
+}
+
+// InterruptedError is returned when the context is done before the operation.
+type InterruptedError struct {
+	OperationID string
+	Err         error
+}
+
+func (e *InterruptedError) Error() string {
+	return fmt.Sprintf("waiting for operation %s was interrupted: %s", e.OperationID, e.Err)
+}
+
+func (e *InterruptedError) Unwrap() error {
+	return e.Err
+}
+
+// IsInterrupted reports whether the operation may still be running because waiting for it was interrupted.
+func IsInterrupted(err error) bool {
+	var interrupted *InterruptedError
+	return errors.As(err, &interrupted)
+}
+
+// Wait waits for the operation to complete and returns its error, reporting the progress every ProgressInterval.
+// If private is not nil, the operation ID is kept in it until the operation is done.
+func Wait(ctx context.Context, op *sdkoperation.Operation, private PrivateState) error {
+	if private != nil && !op.Done() {
+		if err := setPending(ctx, private, op.Id()); err != nil {
+			return err
+		}
+	}
+
+	ctx = tflog.SetField(ctx, "operation_id", op.Id())
+	started := time.Now()
+	for !op.Done() {
+		waitCtx, cancel := context.WithTimeout(ctx, ProgressInterval)
+		err := op.WaitInterval(waitCtx, PollInterval)
+		cancel()
+		if op.Done() {
+			break
+		}
+		if ctx.Err() != nil {
+			tflog.Warn(ctx, "Stopped waiting for operation", map[string]interface{}{"description": op.Description()})
+			return &InterruptedError{OperationID: op.Id(), Err: ctx.Err()}
+		}
+		if waitCtx.Err() == nil {
+			return err
+		}
+		logProgress(ctx, op, time.Since(started))
+	}
+
+	if private != nil {
+		if err := setPending(ctx, private, ""); err != nil {
+			return err
+		}
+	}
+	tflog.Debug(ctx, "Operation done", map[string]interface{}{
+		"description": op.Description(),
+		"elapsed":     time.Since(started).Round(time.Second).String(),
+	})
+
+	return op.Error()
+}
+
+// Resume waits for the operation stored in the private state by the interrupted Wait.
+// It returns nil operation if there is no pending operation.
+func Resume(ctx context.Context, sdk *ycsdk.SDK, private PrivateState) (*sdkoperation.Operation, error) {
+	value, diags := private.GetKey(ctx, PrivateStateKey)
+	if diags.HasError() {
+		return nil, diagsError(diags)
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	var pending pendingOperation
+	if err := json.Unmarshal(value, &pending); err != nil {
+		return nil, fmt.Errorf("failed to decode pending operation %q: %w", value, err)
+	}
+	if pending.ID == "" {
+		return nil, nil
+	}
+
+	tflog.Info(ctx, "Resuming wait for operation", map[string]interface{}{"operation_id": pending.ID})
+	op, err := sdk.WrapOperation(sdk.Operation().Get(ctx, &operation.GetOperationRequest{OperationId: pending.ID}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operation %s: %w", pending.ID, err)
+	}
+	return op, Wait(ctx, op, private)
+}
+
+// RetryConflictingOperation calls the action, waiting for the conflicting operation
+// and calling the action again while it fails because of a conflicting operation.
+func RetryConflictingOperation(ctx context.Context, sdk *ycsdk.SDK, action func() (*operation.Operation, error)) (*sdkoperation.Operation, error) {
+	for {
+		op, err := sdk.WrapOperation(action())
+		if err == nil {
+			return op, nil
+		}
+
+		operationID := conflictingOperationID(err)
+		if operationID == "" {
+			return op, err
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for conflicting operation %q to complete", operationID))
+		req := &operation.GetOperationRequest{OperationId: operationID}
+		op, err = sdk.WrapOperation(sdk.Operation().Get(ctx, req))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := Wait(ctx, op, nil); IsInterrupted(err) {
+			return nil, err
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Conflicting operation %q has completed. Going to retry initial action.", operationID))
+	}
+}
+
+var (
+	conflictGoAPIRegexp = regexp.MustCompile(`conflicting operation "(.+)" detected`)
+	conflictPyAPIRegexp = regexp.MustCompile(`Conflicting operation (.+) detected`)
+)
+
+func conflictingOperationID(err error) string {
+	message := status.Convert(err).Message()
+	if submatch := conflictGoAPIRegexp.FindStringSubmatch(message); len(submatch) > 0 {
+		return submatch[1]
+	}
+	if submatch := conflictPyAPIRegexp.FindStringSubmatch(message); len(submatch) > 0 {
+		return submatch[1]
+	}
+	return ""
+}
+
+func logProgress(ctx context.Context, op *sdkoperation.Operation, elapsed time.Duration) {
+	fields := map[string]interface{}{
+		"description": op.Description(),
+		"elapsed":     elapsed.Round(time.Second).String(),
+	}
+	if op.RawMetadata() != nil {
+		if metadata, err := op.Metadata(); err == nil {
+			fields["metadata"] = protojson.MarshalOptions{}.Format(metadata)
+		}
+	}
+	tflog.Info(ctx, "Operation is still in progress", fields)
+}
+
+func setPending(ctx context.Context, private PrivateState, id string) error {
+	var value []byte
+	if id != "" {
+		var err error
+		if value, err = json.Marshal(pendingOperation{ID: id}); err != nil {
+			return err
+		}
+	}
+	if diags := private.SetKey(ctx, PrivateStateKey, value); diags.HasError() {
+		return diagsError(diags)
+	}
+	return nil
+}
+
+func diagsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+	}
+	return errors.Join(errs...)
+}
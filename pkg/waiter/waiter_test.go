@@ -0,0 +1,140 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	sdkoperation "github.com/yandex-cloud/go-sdk/operation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+	} else {
+		p[key] = value
+	}
+	return nil
+}
+
+// testOperationClient completes the operation after the given number of polls, never if it is negative.
+type testOperationClient struct {
+	operation.OperationServiceClient
+	polls int
+}
+
+func (c *testOperationClient) Get(_ context.Context, req *operation.GetOperationRequest, _ ...grpc.CallOption) (*operation.Operation, error) {
+	c.polls--
+	return &operation.Operation{Id: req.GetOperationId(), Done: c.polls == 0}, nil
+}
+
+func setTestIntervals(t *testing.T) {
+	progress, poll := ProgressInterval, PollInterval
+	ProgressInterval, PollInterval = 20*time.Millisecond, time.Millisecond
+	t.Cleanup(func() {
+		ProgressInterval, PollInterval = progress, poll
+	})
+}
+
+func TestWait(t *testing.T) {
+	setTestIntervals(t)
+
+	private := testPrivateState{}
+	op := sdkoperation.New(&testOperationClient{polls: 100}, &operation.Operation{Id: "op1"})
+
+	require.NoError(t, Wait(context.Background(), op, private))
+	assert.True(t, op.Done())
+	assert.Empty(t, private)
+}
+
+func TestWaitInterrupted(t *testing.T) {
+	setTestIntervals(t)
+
+	private := testPrivateState{}
+	op := sdkoperation.New(&testOperationClient{polls: -1}, &operation.Operation{Id: "op1"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := Wait(ctx, op, private)
+	require.Error(t, err)
+	assert.True(t, IsInterrupted(err))
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.JSONEq(t, `{"id": "op1"}`, string(private[PrivateStateKey]))
+}
+
+func TestResume(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := fakecloud.Start()
+	require.NoError(t, err)
+	defer s.Stop()
+
+	sdk, err := ycsdk.Build(ctx, ycsdk.Config{
+		Credentials: ycsdk.NewIAMTokenCredentials(fakecloud.Token),
+		Endpoint:    s.Endpoint(),
+		Plaintext:   true,
+	})
+	require.NoError(t, err)
+	defer sdk.Shutdown(ctx)
+
+	private := testPrivateState{}
+	op, err := Resume(ctx, sdk, private)
+	require.NoError(t, err)
+	assert.Nil(t, op)
+
+	created, err := sdk.VPC().Network().Create(ctx, &vpc.CreateNetworkRequest{FolderId: s.FolderID, Name: "network"})
+	require.NoError(t, err)
+	private[PrivateStateKey] = []byte(`{"id": "` + created.Id + `"}`)
+
+	op, err = Resume(ctx, sdk, private)
+	require.NoError(t, err)
+	require.NotNil(t, op)
+	assert.Equal(t, created.Id, op.Id())
+	assert.True(t, op.Ok())
+	assert.Empty(t, private)
+}
+
+func TestConflictingOperationID(t *testing.T) {
+	cases := map[string]struct {
+		err      error
+		expected string
+	}{
+		"go api": {
+			err:      status.Error(codes.FailedPrecondition, `conflicting operation "op1" detected`),
+			expected: "op1",
+		},
+		"py api": {
+			err:      status.Error(codes.FailedPrecondition, "Conflicting operation op2 detected"),
+			expected: "op2",
+		},
+		"other error": {
+			err:      status.Error(codes.FailedPrecondition, "cluster is stopped"),
+			expected: "",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, conflictingOperationID(tc.err))
+		})
+	}
+}
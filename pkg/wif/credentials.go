@@ -0,0 +1,128 @@
+// Package wif implements the Yandex Cloud API credentials of the workload identity federation,
+// exchanging a JWT issued by an external OIDC provider, e.g. a CI system, for an IAM token
+// of the federated service account.
+package wif
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	grantTypeTokenExchange = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenTypeAccessToken   = "urn:ietf:params:oauth:token-type:access_token"
+	tokenTypeIDToken       = "urn:ietf:params:oauth:token-type:id_token"
+
+	// refreshBefore is how long before the expiration the IAM token is refreshed,
+	// but not earlier than in the middle of its lifetime.
+	refreshBefore = 5 * time.Minute
+)
+
+// Credentials exchange the JWT read from the token file for an IAM token of the service account.
+// They implement ycsdk.NonExchangeableCredentials: the SDK caches the IAM token and requests a new one
+// when it is about to expire. The token file is read on every exchange, so it can be rotated by the OIDC provider.
+type Credentials struct {
+	TokenFile        string
+	ServiceAccountID string
+	Endpoint         string
+
+	client *http.Client
+	now    func() time.Time
+}
+
+// New returns the credentials of the workload identity federation using the token exchange endpoint.
+func New(tokenFile, serviceAccountID, endpoint string) (*Credentials, error) {
+	if tokenFile == "" {
+		return nil, fmt.Errorf("workload identity federation token file should be specified")
+	}
+	if serviceAccountID == "" {
+		return nil, fmt.Errorf("workload identity federation service account ID should be specified")
+	}
+	if endpoint == "" {
+		return nil, fmt.Errorf("workload identity federation endpoint should be specified")
+	}
+	return &Credentials{
+		TokenFile:        tokenFile,
+		ServiceAccountID: serviceAccountID,
+		Endpoint:         endpoint,
+		client:           &http.Client{Timeout: time.Minute},
+		now:              time.Now,
+	}, nil
+}
+
+func (c *Credentials) YandexCloudAPICredentials() {}
+
+// tokenResponse is the response of the token exchange endpoint, see RFC 8693.
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// IAMToken exchanges the JWT for an IAM token of the service account.
+func (c *Credentials) IAMToken(ctx context.Context) (*iam.CreateIamTokenResponse, error) {
+	subjectToken, err := os.ReadFile(c.TokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workload identity federation token: %w", err)
+	}
+
+	form := url.Values{
+		"grant_type":           {grantTypeTokenExchange},
+		"requested_token_type": {tokenTypeAccessToken},
+		"audience":             {c.ServiceAccountID},
+		"subject_token":        {strings.TrimSpace(string(subjectToken))},
+		"subject_token_type":   {tokenTypeIDToken},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.Endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token exchange request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	issuedAt := c.now()
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange workload identity federation token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token exchange response: %w", err)
+	}
+
+	var token tokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("token exchange failed with status %s", resp.Status)
+		}
+		return nil, fmt.Errorf("failed to parse token exchange response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || token.Error != "" {
+		return nil, fmt.Errorf("token exchange failed with status %s: %s %s", resp.Status, token.Error, token.ErrorDescription)
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("token exchange response has no access token")
+	}
+
+	expiresIn := time.Duration(token.ExpiresIn) * time.Second
+	lifetime := expiresIn - refreshBefore
+	if lifetime < expiresIn/2 {
+		lifetime = expiresIn / 2
+	}
+	return &iam.CreateIamTokenResponse{
+		IamToken:  token.AccessToken,
+		ExpiresAt: timestamppb.New(issuedAt.Add(lifetime)),
+	}, nil
+}
@@ -0,0 +1,155 @@
+package wif
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+)
+
+const testServiceAccountID = "ajetestserviceaccount"
+
+// tokenExchangeStub is a token exchange endpoint issuing IAM tokens for the JWTs it knows.
+type tokenExchangeStub struct {
+	mu        sync.Mutex
+	tokens    map[string]string
+	expiresIn int64
+	requests  int
+}
+
+func (s *tokenExchangeStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := r.ParseForm(); err != nil || r.Method != http.MethodPost ||
+		r.PostForm.Get("grant_type") != grantTypeTokenExchange ||
+		r.PostForm.Get("requested_token_type") != tokenTypeAccessToken ||
+		r.PostForm.Get("subject_token_type") != tokenTypeIDToken ||
+		r.PostForm.Get("audience") != testServiceAccountID {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(tokenResponse{Error: "invalid_request"})
+		return
+	}
+
+	token, ok := s.tokens[r.PostForm.Get("subject_token")]
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(tokenResponse{Error: "invalid_grant", ErrorDescription: "unknown subject token"})
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token":      token,
+		"issued_token_type": tokenTypeAccessToken,
+		"token_type":        "Bearer",
+		"expires_in":        s.expiresIn,
+	})
+}
+
+func startStub(t *testing.T) (*tokenExchangeStub, string) {
+	stub := &tokenExchangeStub{
+		tokens: map[string]string{
+			"jwt-1": fakecloud.Token,
+			"jwt-2": "t1.rotated.token",
+		},
+		expiresIn: 3600,
+	}
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+	return stub, server.URL
+}
+
+func writeToken(t *testing.T, path, token string) {
+	require.NoError(t, os.WriteFile(path, []byte(token+"\n"), 0600))
+}
+
+func TestIAMToken(t *testing.T) {
+	stub, endpoint := startStub(t)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	writeToken(t, tokenFile, "jwt-1")
+
+	creds, err := New(tokenFile, testServiceAccountID, endpoint)
+	require.NoError(t, err)
+	issuedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	creds.now = func() time.Time { return issuedAt }
+
+	resp, err := creds.IAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, fakecloud.Token, resp.IamToken)
+	assert.Equal(t, issuedAt.Add(time.Hour-refreshBefore), resp.ExpiresAt.AsTime())
+
+	// The token file is read again on refresh.
+	writeToken(t, tokenFile, "jwt-2")
+	resp, err = creds.IAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "t1.rotated.token", resp.IamToken)
+
+	// Short-lived tokens are refreshed in the middle of their lifetime.
+	stub.mu.Lock()
+	stub.expiresIn = 60
+	stub.mu.Unlock()
+	resp, err = creds.IAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, issuedAt.Add(30*time.Second), resp.ExpiresAt.AsTime())
+
+	writeToken(t, tokenFile, "jwt-unknown")
+	_, err = creds.IAMToken(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid_grant unknown subject token")
+
+	require.NoError(t, os.Remove(tokenFile))
+	_, err = creds.IAMToken(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read workload identity federation token")
+}
+
+func TestNew(t *testing.T) {
+	_, err := New("", testServiceAccountID, "http://localhost")
+	assert.Error(t, err)
+	_, err = New("token", "", "http://localhost")
+	assert.Error(t, err)
+	_, err = New("token", testServiceAccountID, "")
+	assert.Error(t, err)
+}
+
+func TestSDKCredentials(t *testing.T) {
+	ctx := context.Background()
+	stub, endpoint := startStub(t)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	writeToken(t, tokenFile, "jwt-1")
+
+	s, err := fakecloud.Start()
+	require.NoError(t, err)
+	defer s.Stop()
+
+	creds, err := New(tokenFile, testServiceAccountID, endpoint)
+	require.NoError(t, err)
+	sdk, err := ycsdk.Build(ctx, ycsdk.Config{
+		Credentials: creds,
+		Endpoint:    s.Endpoint(),
+		Plaintext:   true,
+	})
+	require.NoError(t, err)
+	defer sdk.Shutdown(ctx)
+
+	for i := 0; i < 3; i++ {
+		_, err = sdk.ResourceManager().Folder().Get(ctx, &resourcemanager.GetFolderRequest{FolderId: s.FolderID})
+		require.NoError(t, err)
+	}
+	stub.mu.Lock()
+	defer stub.mu.Unlock()
+	assert.Equal(t, 1, stub.requests, "IAM token must be cached by SDK")
+}
@@ -0,0 +1,225 @@
+package accessbinding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"golang.org/x/exp/maps"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type memberResource struct {
+	ResourceUpdater ResourceIamUpdater
+}
+
+// NewIamMember Non-authoritative resource, it grants the role to a single member and preserves other members of the role.
+func NewIamMember(updater ResourceIamUpdater) resource.Resource {
+	return &memberResource{updater}
+}
+
+func (r *memberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.Plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member := getResourceIamMember(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := iamPolicyReadModifyUpdate(ctx, r.ResourceUpdater, &PolicyDelta{
+		Deltas: []*access.AccessBindingDelta{
+			{
+				Action:        access.AccessBindingAction_ADD,
+				AccessBinding: member,
+			},
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Add Resource Policy Member",
+			fmt.Sprintf("An unexpected error occurred while attempting to add resource policy member"+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, req.Plan.Raw)...)
+}
+
+func (r *memberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.State, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eMember := getResourceIamMember(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.ResourceUpdater.GetResourceIamPolicy(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			tflog.Debug(ctx, fmt.Sprintf("Resource %s is missing or deleted, removing member %q from state",
+				r.ResourceUpdater.DescribeResource(), canonicalMember(eMember)))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource Policies",
+			fmt.Sprintf("An unexpected error occurred while refreshing resource policies"+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Retrieved access bindings of %s: %+v", r.ResourceUpdater.DescribeResource(), policy))
+
+	for _, b := range policy.Bindings {
+		if b.RoleId == eMember.RoleId && canonicalMember(b) == canonicalMember(eMember) {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member"), canonicalMember(b))...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), b.RoleId)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.ResourceUpdater.GetIdAlias()), r.ResourceUpdater.GetId())...)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Member %q for role %q does not exist in access bindings of %s, removing from state",
+		canonicalMember(eMember), eMember.RoleId, r.ResourceUpdater.DescribeResource()))
+	resp.State.RemoveResource(ctx)
+}
+
+// Update All attributes of the member require replacement, so only the state is updated.
+func (r *memberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(resp.State.Set(ctx, req.Plan.Raw)...)
+}
+
+func (r *memberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.State, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member := getResourceIamMember(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := iamPolicyReadModifyUpdate(ctx, r.ResourceUpdater, &PolicyDelta{
+		Deltas: []*access.AccessBindingDelta{
+			{
+				Action:        access.AccessBindingAction_REMOVE,
+				AccessBinding: member,
+			},
+		},
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			tflog.Debug(ctx, fmt.Sprintf("Resource %s is missing or deleted, marking member %q as deleted",
+				r.ResourceUpdater.DescribeResource(), canonicalMember(member)))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Remove Resource Policy Member",
+			fmt.Sprintf("An unexpected error occurred while removing resource policy member"+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err))
+	}
+}
+
+func (r *memberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + nameSuffix(r.ResourceUpdater, "member")
+}
+
+func (r *memberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.ResourceUpdater.Configure(ctx, req, resp)
+}
+
+func (r *memberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"member": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{memberValidator{}},
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, replaceableAttributes(r.ResourceUpdater))
+}
+
+func (r *memberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: {resource_id},{role},{member}. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.ResourceUpdater.GetIdAlias()), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member"), idParts[2])...)
+}
+
+func getResourceIamMember(ctx context.Context, state Extractable, diag *diag.Diagnostics) *access.AccessBinding {
+	var role, member types.String
+
+	diag.Append(state.GetAttribute(ctx, path.Root("role"), &role)...)
+	diag.Append(state.GetAttribute(ctx, path.Root("member"), &member)...)
+
+	if !strings.Contains(member.ValueString(), ":") {
+		diag.AddAttributeError(path.Root("member"), "Invalid Member",
+			fmt.Sprintf("Expected member in TYPE:ID format, got %q", member.ValueString()))
+		return nil
+	}
+	return roleMemberToAccessBinding(role.ValueString(), member.ValueString())
+}
+
+type memberValidator struct{}
+
+func (v memberValidator) Description(_ context.Context) string {
+	return "Validate member is in TYPE:ID format"
+}
+
+func (v memberValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v memberValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	chunks := strings.SplitN(req.ConfigValue.ValueString(), ":", 2)
+	if len(chunks) == 1 || chunks[0] == "" || chunks[1] == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Member",
+			fmt.Sprintf("Expected member in TYPE:ID format, got %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
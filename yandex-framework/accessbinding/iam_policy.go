@@ -0,0 +1,232 @@
+package accessbinding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/exp/maps"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type policyResource struct {
+	ResourceUpdater ResourceIamUpdater
+}
+
+// NewIamPolicy Authoritative resource, it replaces all access bindings of the resource with the given policy.
+func NewIamPolicy(updater ResourceIamUpdater) resource.Resource {
+	return &policyResource{updater}
+}
+
+func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.Plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setPolicyData(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, req.Plan.Raw)...)
+}
+
+func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.State, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.ResourceUpdater.GetResourceIamPolicy(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			tflog.Debug(ctx, fmt.Sprintf("Resource %s is missing or deleted, removing policy from state",
+				r.ResourceUpdater.DescribeResource()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource Policies",
+			fmt.Sprintf("An unexpected error occurred while refreshing resource policies"+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Retrieved access bindings of %s: %+v", r.ResourceUpdater.DescribeResource(), policy))
+
+	var policyData types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("policy_data"), &policyData)...)
+
+	// Keep the configured representation of the policy if it has the same bindings to avoid diff
+	// caused by the order of the bindings.
+	if state, err := unmarshalIamPolicy(policyData.ValueString()); err == nil && equalPolicies(state, policy) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_data"), marshalIamPolicy(policy))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.ResourceUpdater.GetIdAlias()), r.ResourceUpdater.GetId())...)
+}
+
+func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.Plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setPolicyData(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, req.Plan.Raw)...)
+}
+
+func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.State, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set an empty policy to delete the attached policy.
+	err := iamPolicyReadModifySet(ctx, r.ResourceUpdater, func(p *Policy) error {
+		p.Bindings = nil
+		return nil
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			tflog.Debug(ctx, fmt.Sprintf("Resource %s is missing or deleted, marking policy as deleted",
+				r.ResourceUpdater.DescribeResource()))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource Policies",
+			fmt.Sprintf("An unexpected error occurred while deleting resource policies"+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err))
+	}
+}
+
+func (r *policyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + nameSuffix(r.ResourceUpdater, "policy")
+}
+
+func (r *policyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.ResourceUpdater.Configure(ctx, req, resp)
+}
+
+func (r *policyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"policy_data": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{policyValidator{}},
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, replaceableAttributes(r.ResourceUpdater))
+}
+
+func (r *policyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(r.ResourceUpdater.GetIdAlias()), req, resp)
+}
+
+func (r *policyResource) setPolicyData(ctx context.Context, plan Extractable, diag *diag.Diagnostics) {
+	var policyData types.String
+	diag.Append(plan.GetAttribute(ctx, path.Root("policy_data"), &policyData)...)
+	if diag.HasError() {
+		return
+	}
+
+	policy, err := unmarshalIamPolicy(policyData.ValueString())
+	if err != nil {
+		diag.AddAttributeError(path.Root("policy_data"), "Invalid Policy Data",
+			fmt.Sprintf("'policy_data' is not valid for %s: %s", r.ResourceUpdater.DescribeResource(), err))
+		return
+	}
+
+	err = iamPolicyReadModifySet(ctx, r.ResourceUpdater, func(p *Policy) error {
+		p.Bindings = policy.Bindings
+		return nil
+	})
+	if err != nil {
+		diag.AddError(
+			"Unable to Set Resource Policies",
+			fmt.Sprintf("An unexpected error occurred while setting resource policies"+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err))
+	}
+}
+
+func marshalIamPolicy(policy *Policy) string {
+	pdBytes, _ := json.Marshal(&Policy{
+		Bindings: policy.Bindings,
+	})
+
+	return string(pdBytes)
+}
+
+func unmarshalIamPolicy(policyData string) (*Policy, error) {
+	policy := &Policy{}
+	if err := json.Unmarshal([]byte(policyData), policy); err != nil {
+		return nil, fmt.Errorf("Could not unmarshal policy data %s:\n%w", policyData, err)
+	}
+	return policy, nil
+}
+
+// equalPolicies Compares policies regardless of the order and duplicates of the bindings.
+func equalPolicies(a, b *Policy) bool {
+	aMembers := bindingKeys(a)
+	bMembers := bindingKeys(b)
+	if len(aMembers) != len(bMembers) {
+		return false
+	}
+	for i := range aMembers {
+		if aMembers[i] != bMembers[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func bindingKeys(p *Policy) []string {
+	var keys []string
+	for _, b := range mergeBindings(p.Bindings) {
+		keys = append(keys, b.RoleId+"\x00"+canonicalMember(b))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+type policyValidator struct{}
+
+func (v policyValidator) Description(_ context.Context) string {
+	return "Validate policy data is a JSON policy"
+}
+
+func (v policyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v policyValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := unmarshalIamPolicy(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Policy Data", err.Error())
+	}
+}
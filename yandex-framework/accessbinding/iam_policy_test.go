@@ -0,0 +1,130 @@
+package accessbinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+// testUpdater implements only the methods of the updater used to build the schema and the name of the resources.
+type testUpdater struct {
+	ResourceIamUpdater
+}
+
+func (testUpdater) GetNameSuffix() string {
+	return "datasphere_project_iam_binding"
+}
+
+func (testUpdater) GetSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"project_id": schema.StringAttribute{
+			Required: true,
+		},
+		"sleep_after": schema.Int64Attribute{
+			Optional: true,
+		},
+	}
+}
+
+func testBinding(role, member string) *access.AccessBinding {
+	return roleMemberToAccessBinding(role, member)
+}
+
+func TestEqualPolicies(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     []*access.AccessBinding
+		b     []*access.AccessBinding
+		equal bool
+	}{
+		{
+			name:  "empty",
+			equal: true,
+		},
+		{
+			name:  "same order",
+			a:     []*access.AccessBinding{testBinding("viewer", "userAccount:a"), testBinding("editor", "userAccount:b")},
+			b:     []*access.AccessBinding{testBinding("viewer", "userAccount:a"), testBinding("editor", "userAccount:b")},
+			equal: true,
+		},
+		{
+			name:  "different order",
+			a:     []*access.AccessBinding{testBinding("viewer", "userAccount:a"), testBinding("editor", "userAccount:b")},
+			b:     []*access.AccessBinding{testBinding("editor", "userAccount:b"), testBinding("viewer", "userAccount:a")},
+			equal: true,
+		},
+		{
+			name:  "duplicates",
+			a:     []*access.AccessBinding{testBinding("viewer", "userAccount:a"), testBinding("viewer", "userAccount:a")},
+			b:     []*access.AccessBinding{testBinding("viewer", "userAccount:a")},
+			equal: true,
+		},
+		{
+			name: "different members",
+			a:    []*access.AccessBinding{testBinding("viewer", "userAccount:a")},
+			b:    []*access.AccessBinding{testBinding("viewer", "userAccount:b")},
+		},
+		{
+			name: "different member types",
+			a:    []*access.AccessBinding{testBinding("viewer", "userAccount:a")},
+			b:    []*access.AccessBinding{testBinding("viewer", "serviceAccount:a")},
+		},
+		{
+			name: "different roles",
+			a:    []*access.AccessBinding{testBinding("viewer", "userAccount:a")},
+			b:    []*access.AccessBinding{testBinding("editor", "userAccount:a")},
+		},
+		{
+			name: "extra member",
+			a:    []*access.AccessBinding{testBinding("viewer", "userAccount:a")},
+			b:    []*access.AccessBinding{testBinding("viewer", "userAccount:a"), testBinding("viewer", "userAccount:b")},
+		},
+		{
+			name: "empty and non-empty",
+			b:    []*access.AccessBinding{testBinding("viewer", "userAccount:a")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := &Policy{Bindings: tt.a}, &Policy{Bindings: tt.b}
+			assert.Equal(t, tt.equal, equalPolicies(a, b))
+			assert.Equal(t, tt.equal, equalPolicies(b, a))
+		})
+	}
+}
+
+func TestNameSuffix(t *testing.T) {
+	tests := []struct {
+		kind     string
+		expected string
+	}{
+		{kind: "binding", expected: "datasphere_project_iam_binding"},
+		{kind: "member", expected: "datasphere_project_iam_member"},
+		{kind: "policy", expected: "datasphere_project_iam_policy"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			assert.Equal(t, tt.expected, nameSuffix(testUpdater{}, tt.kind))
+		})
+	}
+}
+
+func TestReplaceableAttributes(t *testing.T) {
+	attributes := replaceableAttributes(testUpdater{})
+	require.Len(t, attributes, 2)
+
+	id, ok := attributes["project_id"].(schema.StringAttribute)
+	require.True(t, ok)
+	assert.True(t, id.Required)
+	require.Len(t, id.PlanModifiers, 1)
+	ctx := context.Background()
+	assert.Equal(t, stringplanmodifier.RequiresReplace().Description(ctx), id.PlanModifiers[0].Description(ctx))
+
+	// The attributes other than strings are kept as is.
+	assert.Equal(t, schema.Int64Attribute{Optional: true}, attributes["sleep_after"])
+}
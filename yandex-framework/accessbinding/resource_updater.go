@@ -10,10 +10,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/terraform-provider-yandex/common/iambatch"
 	"github.com/yandex-cloud/terraform-provider-yandex/common/mutexkv"
 )
 
 var mutexKV = mutexkv.NewMutexKV()
+var iamBatcher = iambatch.NewBatcher(mutexKV)
 
 type Policy struct {
 	Bindings []*access.AccessBinding
@@ -54,6 +56,7 @@ type ResourceIamUpdater interface {
 	GetSchemaAttributes() map[string]schema.Attribute
 
 	// GetNameSuffix Gets resource terraform name suffix without leading underscore.
+	// The suffix names the `iam_binding` resource, `iam_member` and `iam_policy` resources replace its ending.
 	GetNameSuffix() string
 
 	// GetIdAlias Gets resource id alias that used in resource schema for resource configuration.
@@ -103,3 +106,21 @@ func iamPolicyReadModifySet(ctx context.Context, updater ResourceIamUpdater, mod
 
 	return nil
 }
+
+// iamPolicyReadModifyUpdate Applies the deltas together with the deltas of the concurrent calls for the same resource.
+func iamPolicyReadModifyUpdate(ctx context.Context, updater ResourceIamUpdater, policyDelta *PolicyDelta) error {
+	tflog.Debug(ctx, fmt.Sprintf("Updating access bindings of %s with %+v", updater.DescribeResource(), policyDelta))
+
+	err := iamBatcher.Update(ctx, updater.GetMutexKey(), policyDelta.Deltas,
+		func(ctx context.Context, deltas []*access.AccessBindingDelta) error {
+			return updater.UpdateResourceIamPolicy(ctx, &PolicyDelta{Deltas: deltas})
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("Error updating access bindings of %s: %w", updater.DescribeResource(), err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updated access bindings for %s", updater.DescribeResource()))
+
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
 )
 
@@ -96,3 +98,21 @@ func CountBatches(size, batchSize int) int {
 	}
 	return iterations
 }
+
+// nameSuffix Gets terraform name suffix of the given IAM resource kind, e.g. `member` for `..._iam_member`.
+// Updaters return the suffix of the `iam_binding` resource.
+func nameSuffix(updater ResourceIamUpdater, kind string) string {
+	return strings.TrimSuffix(updater.GetNameSuffix(), "_iam_binding") + "_iam_" + kind
+}
+
+// replaceableAttributes Gets resource iam schema, the resource id can't be changed without replacement.
+func replaceableAttributes(updater ResourceIamUpdater) map[string]schema.Attribute {
+	attributes := updater.GetSchemaAttributes()
+	for name, attr := range attributes {
+		if s, ok := attr.(schema.StringAttribute); ok {
+			s.PlanModifiers = append(s.PlanModifiers, stringplanmodifier.RequiresReplace())
+			attributes[name] = s
+		}
+	}
+	return attributes
+}
@@ -0,0 +1,111 @@
+package labels
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const EffectiveLabelsAttributeName = "effective_labels"
+
+// EffectiveLabelsAttribute returns schema of the computed attribute holding all labels present on the resource,
+// including the `default_labels` configured on the provider.
+func EffectiveLabelsAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: "All labels present on the resource, including the `default_labels` configured on the provider.",
+	}
+}
+
+// ModifyPlan sets planned `effective_labels` to the provider default labels merged with the resource `labels`.
+func ModifyPlan(ctx context.Context, defaults types.Map, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("labels"), &planned)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if planned.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(EffectiveLabelsAttributeName), types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	effective, diags := Merge(ctx, defaults, planned)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(EffectiveLabelsAttributeName), effective)...)
+}
+
+// Merge returns provider default labels overridden by the resource labels.
+func Merge(ctx context.Context, defaults, labels types.Map) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	merged := make(map[string]string)
+	diags.Append(elements(ctx, defaults, merged)...)
+	diags.Append(elements(ctx, labels, merged)...)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	result, d := types.MapValueFrom(ctx, types.StringType, merged)
+	diags.Append(d...)
+	return result, diags
+}
+
+// Strip removes from effective labels the ones injected by the provider,
+// i.e. having the default value and not set in the resource configuration.
+func Strip(ctx context.Context, defaults, effective, configured types.Map) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	defaultLabels := make(map[string]string)
+	effectiveLabels := make(map[string]string)
+	configuredLabels := make(map[string]string)
+	diags.Append(elements(ctx, defaults, defaultLabels)...)
+	diags.Append(elements(ctx, effective, effectiveLabels)...)
+	diags.Append(elements(ctx, configured, configuredLabels)...)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	stripped := make(map[string]string, len(effectiveLabels))
+	for k, v := range effectiveLabels {
+		if _, ok := configuredLabels[k]; !ok {
+			if dv, ok := defaultLabels[k]; ok && dv == v {
+				continue
+			}
+		}
+		stripped[k] = v
+	}
+
+	if len(stripped) == 0 && configured.IsNull() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	result, d := types.MapValueFrom(ctx, types.StringType, stripped)
+	diags.Append(d...)
+	return result, diags
+}
+
+func elements(ctx context.Context, m types.Map, target map[string]string) diag.Diagnostics {
+	if m.IsNull() || m.IsUnknown() {
+		return nil
+	}
+
+	values := make(map[string]string, len(m.Elements()))
+	diags := m.ElementsAs(ctx, &values, false)
+	for k, v := range values {
+		target[k] = v
+	}
+	return diags
+}
@@ -27,7 +27,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/impersonate"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/ratelimit"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/wif"
 )
 
 const (
@@ -66,11 +69,46 @@ type State struct {
 
 	SharedCredentialsFile types.String `tfsdk:"shared_credentials_file"`
 	Profile               types.String `tfsdk:"profile"`
+	DefaultLabels         types.Map    `tfsdk:"default_labels"`
+	RateLimits            []RateLimit  `tfsdk:"rate_limit"`
+	APITraceFile          types.String `tfsdk:"api_trace_file"`
+
+	WorkloadIdentityFederation []WorkloadIdentityFederation `tfsdk:"workload_identity_federation"`
+
+	ImpersonateServiceAccountID        types.String `tfsdk:"impersonate_service_account_id"`
+	ImpersonateServiceAccountDelegates types.List   `tfsdk:"impersonate_service_account_delegates"`
 	//
 	//sharedCredentials *SharedCredentials
 	//defaultS3Client   *s3.S3
 }
 
+type RateLimit struct {
+	Service               types.String  `tfsdk:"service"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	Burst                 types.Int64   `tfsdk:"burst"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+}
+
+type WorkloadIdentityFederation struct {
+	TokenFile        types.String `tfsdk:"token_file"`
+	ServiceAccountID types.String `tfsdk:"service_account_id"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+}
+
+// Limits returns the client-side limits of the API requests.
+func (s *State) Limits() []ratelimit.Limit {
+	var limits []ratelimit.Limit
+	for _, l := range s.RateLimits {
+		limits = append(limits, ratelimit.Limit{
+			Service:               l.Service.ValueString(),
+			RequestsPerSecond:     l.RequestsPerSecond.ValueFloat64(),
+			Burst:                 int(l.Burst.ValueInt64()),
+			MaxConcurrentRequests: int(l.MaxConcurrentRequests.ValueInt64()),
+		})
+	}
+	return limits
+}
+
 // TODO: remove yandex.Config when it is not used
 type Config struct {
 	ProviderState State
@@ -112,34 +150,68 @@ func (c *Config) InitAndValidate(ctx context.Context, terraformVersion string, s
 
 	retryInterceptor := retry.Interceptor(
 		retry.WithMax(int(c.ProviderState.MaxRetries.ValueInt64())),
-		retry.WithCodes(codes.Unavailable),
+		retry.WithCodes(codes.Unavailable, codes.ResourceExhausted),
 		retry.WithAttemptHeader(true),
 		retry.WithBackoff(backoffExponentialWithJitter(defaultExponentialBackoffBase, defaultExponentialBackoffCap)))
 
 	var interceptors = []grpc.UnaryClientInterceptor{
 		retryInterceptor,
-		requestIDInterceptor,
 	}
 
+	// Rate limit interceptor is below retry interceptor, so that every retry attempt is limited too.
+	if rateLimitInterceptor := ratelimit.NewInterceptor(c.ProviderState.Limits()); rateLimitInterceptor != nil {
+		interceptors = append(interceptors, rateLimitInterceptor)
+	}
+	interceptors = append(interceptors, requestIDInterceptor)
+
 	// Support deep API logging in case user has requested it.
 	if os.Getenv("TF_ENABLE_API_LOGGING") != "" {
 		log.Print("[INFO] API logging has been requested, turning on")
 		interceptors = append(interceptors, logging.NewAPILoggingUnaryInterceptor())
 	}
 
+	// Trace interceptor is below id interceptor to record the request id of every attempt.
+	if c.ProviderState.APITraceFile.ValueString() != "" {
+		traceInterceptor, err := logging.NewTraceFileInterceptor(c.ProviderState.APITraceFile.ValueString())
+		if err != nil {
+			return err
+		}
+		interceptors = append(interceptors, traceInterceptor)
+	}
+
 	// Make sure retry interceptor is above id interceptor.
 	// Now we will have new request id for every retry attempt.
 	interceptorChain := grpc_middleware.ChainUnaryClient(interceptors...)
 
-	c.SDK, err = ycsdk.Build(ctx, *yandexSDKConfig,
+	dialOptions := []grpc.DialOption{
 		grpc.WithUserAgent(c.UserAgent.ValueString()),
 		grpc.WithDefaultCallOptions(grpc.Header(&headerMD)),
-		grpc.WithUnaryInterceptor(interceptorChain))
+		grpc.WithUnaryInterceptor(interceptorChain),
+	}
+
+	if c.ProviderState.ImpersonateServiceAccountID.ValueString() != "" {
+		var delegates []string
+		if diags := c.ProviderState.ImpersonateServiceAccountDelegates.ElementsAs(ctx, &delegates, false); diags.HasError() {
+			return fmt.Errorf("failed to read impersonate_service_account_delegates: %v", diags)
+		}
+		yandexSDKConfig.Credentials, err = impersonate.New(*yandexSDKConfig,
+			c.ProviderState.ImpersonateServiceAccountID.ValueString(), delegates, dialOptions...)
+		if err != nil {
+			return err
+		}
+	}
+
+	c.SDK, err = ycsdk.Build(ctx, *yandexSDKConfig, dialOptions...)
 
 	return err
 }
 
 func (c *Config) Credentials(ctx context.Context) (ycsdk.Credentials, error) {
+	if len(c.ProviderState.WorkloadIdentityFederation) > 0 {
+		federation := c.ProviderState.WorkloadIdentityFederation[0]
+		return wif.New(federation.TokenFile.ValueString(), federation.ServiceAccountID.ValueString(), federation.Endpoint.ValueString())
+	}
+
 	if c.ProviderState.ServiceAccountKeyFileOrContent.ValueString() != "" {
 		contents, _, err := pathOrContents(c.ProviderState.ServiceAccountKeyFileOrContent.ValueString())
 		if err != nil {
@@ -168,7 +240,7 @@ func (c *Config) Credentials(ctx context.Context) (ycsdk.Credentials, error) {
 		return sa, nil
 	}
 
-	return nil, fmt.Errorf("one of 'token' or 'service_account_key_file' should be specified;" +
+	return nil, fmt.Errorf("one of 'token', 'service_account_key_file' or 'workload_identity_federation' should be specified;" +
 		" if you are inside compute instance, you can attach service account to it in order to " +
 		"authenticate via instance service account")
 }
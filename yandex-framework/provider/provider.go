@@ -7,8 +7,10 @@ import (
 	"os"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,12 +18,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/ratelimit"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sharedcredentials"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/billing"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/clientconfig"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/datasphere/community"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/datasphere/project"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/iam/serviceaccountkey"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/lockbox/secretversion"
+	chdatabase "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb/clickhouse/database"
+	chuser "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb/clickhouse/user"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb/mongodb/database"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb/mongodb/user"
+	sqldatabase "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb/sqlserver/database"
+	sqluser "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb/sqlserver/user"
 )
 
 type saKeyValidator struct{}
@@ -159,6 +170,71 @@ func (p *Provider) Schema(ctx context.Context, req provider.SchemaRequest, resp
 				Optional:    true,
 				Description: common.Descriptions["profile"],
 			},
+			"default_labels": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: common.Descriptions["default_labels"],
+			},
+			"api_trace_file": schema.StringAttribute{
+				Optional:    true,
+				Description: common.Descriptions["api_trace_file"],
+			},
+			"impersonate_service_account_id": schema.StringAttribute{
+				Optional:    true,
+				Description: common.Descriptions["impersonate_service_account_id"],
+			},
+			"impersonate_service_account_delegates": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: common.Descriptions["impersonate_service_account_delegates"],
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"workload_identity_federation": schema.ListNestedBlock{
+				Description: common.Descriptions["workload_identity_federation"],
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"token_file": schema.StringAttribute{
+							Required:    true,
+							Description: common.Descriptions["workload_identity_federation_token_file"],
+						},
+						"service_account_id": schema.StringAttribute{
+							Required:    true,
+							Description: common.Descriptions["workload_identity_federation_service_account_id"],
+						},
+						"endpoint": schema.StringAttribute{
+							Optional:    true,
+							Description: common.Descriptions["workload_identity_federation_endpoint"],
+						},
+					},
+				},
+			},
+			"rate_limit": schema.ListNestedBlock{
+				Description: common.Descriptions["rate_limit"],
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"service": schema.StringAttribute{
+							Optional:    true,
+							Description: common.Descriptions["rate_limit_service"],
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: common.Descriptions["rate_limit_requests_per_second"],
+						},
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: common.Descriptions["rate_limit_burst"],
+						},
+						"max_concurrent_requests": schema.Int64Attribute{
+							Optional:    true,
+							Description: common.Descriptions["rate_limit_max_concurrent_requests"],
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -174,25 +250,48 @@ func setToDefaultIfNeeded(field types.String, osEnvName string, defaultVal strin
 	return field
 }
 
+// stringOrDefault returns the value, or the default value if the value is empty.
+func stringOrDefault(value string, defaultVal string) string {
+	if value == "" {
+		return defaultVal
+	}
+	return value
+}
+
 func setToDefaultBoolIfNeeded(field types.Bool, osEnvName string, defaultVal bool) types.Bool {
 	if field.IsUnknown() || field.IsNull() {
 		env := os.Getenv(osEnvName)
 		v, err := strconv.ParseBool(env)
 		if err != nil {
-			return types.BoolValue(v)
+			return types.BoolValue(defaultVal)
 		}
-		return types.BoolValue(defaultVal)
+		return types.BoolValue(v)
 	}
 	return field
 }
 
-func setDefaults(config provider_config.State) provider_config.State {
-	config.Endpoint = setToDefaultIfNeeded(config.Endpoint, "YC_ENDPOINT", common.DefaultEndpoint)
-	config.FolderID = setToDefaultIfNeeded(config.FolderID, "YC_FOLDER_ID", "")
-	config.CloudID = setToDefaultIfNeeded(config.CloudID, "YC_CLOUD_ID", "")
+func setDefaults(config provider_config.State) (provider_config.State, error) {
+	// Settings of the shared credentials file profile take precedence over the defaults only,
+	// the provider arguments and the environment variables take precedence over them.
+	shared := &sharedcredentials.Credentials{}
+	if config.SharedCredentialsFile.ValueString() != "" {
+		var err error
+		sharedCredentialsProvider := sharedcredentials.Provider{
+			Filename: config.SharedCredentialsFile.ValueString(),
+			// The empty profile selects the current profile of the yc CLI config or the default one.
+			Profile: config.Profile.ValueString(),
+		}
+		if shared, err = sharedCredentialsProvider.Retrieve(); err != nil {
+			return config, err
+		}
+	}
+
+	config.Endpoint = setToDefaultIfNeeded(config.Endpoint, "YC_ENDPOINT", stringOrDefault(shared.Endpoint, common.DefaultEndpoint))
+	config.FolderID = setToDefaultIfNeeded(config.FolderID, "YC_FOLDER_ID", shared.FolderID)
+	config.CloudID = setToDefaultIfNeeded(config.CloudID, "YC_CLOUD_ID", shared.CloudID)
 	config.OrganizationID = setToDefaultIfNeeded(config.OrganizationID, "YC_ORGANIZATION_ID", "")
 	config.Region = setToDefaultIfNeeded(config.Region, "YC_REGION", common.DefaultRegion)
-	config.Zone = setToDefaultIfNeeded(config.Zone, "YC_ZONE", "")
+	config.Zone = setToDefaultIfNeeded(config.Zone, "YC_ZONE", shared.Zone)
 	config.Token = setToDefaultIfNeeded(config.Token, "YC_TOKEN", "")
 	config.ServiceAccountKeyFileOrContent = setToDefaultIfNeeded(config.ServiceAccountKeyFileOrContent, "YC_SERVICE_ACCOUNT_KEY_FILE", "")
 	config.StorageEndpoint = setToDefaultIfNeeded(config.StorageEndpoint, "YC_STORAGE_ENDPOINT_URL", common.DefaultStorageEndpoint)
@@ -201,6 +300,28 @@ func setDefaults(config provider_config.State) provider_config.State {
 	config.YMQEndpoint = setToDefaultIfNeeded(config.YMQEndpoint, "YC_MESSAGE_QUEUE_ENDPOINT", common.DefaultYMQEndpoint)
 	config.YMQAccessKey = setToDefaultIfNeeded(config.YMQAccessKey, "YC_MESSAGE_QUEUE_ACCESS_KEY", "")
 	config.YMQSecretKey = setToDefaultIfNeeded(config.YMQSecretKey, "YC_MESSAGE_QUEUE_SECRET_KEY", "")
+	config.APITraceFile = setToDefaultIfNeeded(config.APITraceFile, "YC_API_TRACE_FILE", "")
+	config.ImpersonateServiceAccountID = setToDefaultIfNeeded(config.ImpersonateServiceAccountID, "YC_IMPERSONATE_SERVICE_ACCOUNT_ID", "")
+
+	// Credentials of the profile are used as a whole, if the provider has none.
+	if config.Token.ValueString() == "" && config.ServiceAccountKeyFileOrContent.ValueString() == "" {
+		config.Token = types.StringValue(shared.Token)
+		config.ServiceAccountKeyFileOrContent = types.StringValue(shared.ServiceAccountKeyFileOrContent)
+	}
+	if config.YMQAccessKey.ValueString() == "" && config.YMQSecretKey.ValueString() == "" {
+		config.YMQAccessKey = types.StringValue(shared.YMQAccessKey)
+		config.YMQSecretKey = types.StringValue(shared.YMQSecretKey)
+	}
+	if (config.StorageAccessKey.ValueString() == "" || config.StorageSecretKey.ValueString() == "") && shared.HasStorageAccessKeys() {
+		config.StorageAccessKey = types.StringValue(shared.StorageAccessKey)
+		config.StorageSecretKey = types.StringValue(shared.StorageSecretKey)
+	}
+
+	for i, federation := range config.WorkloadIdentityFederation {
+		if federation.Endpoint.ValueString() == "" {
+			config.WorkloadIdentityFederation[i].Endpoint = types.StringValue(common.DefaultWIFEndpoint)
+		}
+	}
 
 	config.Insecure = setToDefaultBoolIfNeeded(config.Insecure, "YC_INSECURE", false)
 	config.Plaintext = setToDefaultBoolIfNeeded(config.Plaintext, "YC_PLAINTEXT", false)
@@ -208,11 +329,8 @@ func setDefaults(config provider_config.State) provider_config.State {
 	if config.MaxRetries.IsUnknown() || config.MaxRetries.IsNull() {
 		config.MaxRetries = types.Int64Value(common.DefaultMaxRetries)
 	}
-	if config.Profile.IsUnknown() || config.Profile.IsNull() {
-		config.Profile = types.StringValue("default")
-	}
 
-	return config
+	return config, nil
 }
 
 func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -220,16 +338,26 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	p.config = provider_config.Config{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &p.config.ProviderState)...)
 	p.config.UserAgent = types.StringValue(req.TerraformVersion)
-	p.config.ProviderState = setDefaults(p.config.ProviderState)
+	state, err := setDefaults(p.config.ProviderState)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read shared credentials file", err.Error())
+		return
+	}
+	p.config.ProviderState = state
 	if p.emptyFolder {
 		p.config.ProviderState.FolderID = types.StringValue("")
 	}
+	if err := ratelimit.Validate(p.config.ProviderState.Limits()); err != nil {
+		resp.Diagnostics.AddError("Invalid rate limit", err.Error())
+		return
+	}
 
 	if err := p.config.InitAndValidate(ctx, req.TerraformVersion, false); err != nil {
 		resp.Diagnostics.AddError("Failed to configure", err.Error())
 	}
 	resp.ResourceData = &p.config
 	resp.DataSourceData = &p.config
+	resp.EphemeralResourceData = &p.config
 }
 
 func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
@@ -241,10 +369,18 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		},
 		project.NewResource,
 		project.NewIamBinding,
+		project.NewIamMember,
+		project.NewIamPolicy,
 		community.NewResource,
 		community.NewIamBinding,
+		community.NewIamMember,
+		community.NewIamPolicy,
 		database.NewResource,
 		user.NewResource,
+		chdatabase.NewResource,
+		chuser.NewResource,
+		sqldatabase.NewResource,
+		sqluser.NewResource,
 	}
 }
 
@@ -259,6 +395,18 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		community.NewDataSource,
 		database.NewDataSource,
 		user.NewDataSource,
+		chdatabase.NewDataSource,
+		chuser.NewDataSource,
+		sqldatabase.NewDataSource,
+		sqluser.NewDataSource,
+	}
+}
+
+func (p *Provider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		clientconfig.NewEphemeralResource,
+		secretversion.NewEphemeralResource,
+		serviceaccountkey.NewEphemeralResource,
 	}
 }
 
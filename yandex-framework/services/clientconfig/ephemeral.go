@@ -0,0 +1,96 @@
+package clientconfig
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+type ClientConfig struct {
+	CloudID   types.String `tfsdk:"cloud_id"`
+	FolderID  types.String `tfsdk:"folder_id"`
+	Zone      types.String `tfsdk:"zone"`
+	IAMToken  types.String `tfsdk:"iam_token"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+type clientConfigEphemeralResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &clientConfigEphemeralResource{}
+}
+
+func (r *clientConfigEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_config"
+}
+
+func (r *clientConfigEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected EphemeralResource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *clientConfigEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issues an IAM token for the provider credentials without persisting it in the state.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"folder_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"zone": schema.StringAttribute{
+				Computed: true,
+			},
+			"iam_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expires_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *clientConfigEphemeralResource) Open(ctx context.Context, _ ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	// The token is issued by the SDK with the same credentials the provider was configured with,
+	// so every supported authentication method works here as well.
+	token, err := r.providerConfig.SDK.CreateIAMToken(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Open ephemeral resource",
+			"Error while requesting API to create IAM token:"+err.Error(),
+		)
+		return
+	}
+
+	state := ClientConfig{
+		CloudID:   r.providerConfig.ProviderState.CloudID,
+		FolderID:  r.providerConfig.ProviderState.FolderID,
+		Zone:      r.providerConfig.ProviderState.Zone,
+		IAMToken:  types.StringValue(token.GetIamToken()),
+		ExpiresAt: types.StringValue(token.GetExpiresAt().AsTime().Format(time.RFC3339)),
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &state)...)
+}
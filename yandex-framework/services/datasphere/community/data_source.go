@@ -37,6 +37,7 @@ func (d *communityDataSource) Schema(ctx context.Context, _ datasource.SchemaReq
 			"name":               schema.StringAttribute{Computed: true},
 			"description":        schema.StringAttribute{Computed: true},
 			"labels":             schema.MapAttribute{Computed: true, ElementType: types.StringType},
+			"effective_labels":   schema.MapAttribute{Computed: true, ElementType: types.StringType},
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
@@ -8,3 +8,11 @@ import (
 func NewIamBinding() resource.Resource {
 	return accessbinding.NewIamBinding(newCommunityIamUpdater())
 }
+
+func NewIamMember() resource.Resource {
+	return accessbinding.NewIamMember(newCommunityIamUpdater())
+}
+
+func NewIamPolicy() resource.Resource {
+	return accessbinding.NewIamPolicy(newCommunityIamUpdater())
+}
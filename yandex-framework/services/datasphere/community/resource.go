@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/datasphere/v2"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/labels"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	"google.golang.org/genproto/protobuf/field_mask"
 )
@@ -61,9 +63,9 @@ func (r *communityResource) Create(ctx context.Context, req resource.CreateReque
 		OrganizationId:   plannedCommunity.OrganizationId.ValueString(),
 		BillingAccountId: plannedCommunity.BillingAccountId.ValueString(),
 	}
-	if !plannedCommunity.Labels.IsNull() && !plannedCommunity.Labels.IsUnknown() {
-		labels := make(map[string]string, len(plannedCommunity.Labels.Elements()))
-		resp.Diagnostics.Append(plannedCommunity.Labels.ElementsAs(ctx, &labels, false)...)
+	if !plannedCommunity.EffectiveLabels.IsNull() && !plannedCommunity.EffectiveLabels.IsUnknown() {
+		labels := make(map[string]string, len(plannedCommunity.EffectiveLabels.Elements()))
+		resp.Diagnostics.Append(plannedCommunity.EffectiveLabels.ElementsAs(ctx, &labels, false)...)
 		createCommunityRequestData.SetLabels(labels)
 
 	}
@@ -122,7 +124,9 @@ func (r *communityResource) Create(ctx context.Context, req resource.CreateReque
 
 	plannedCommunity.Id = types.StringValue(createdCommunity.Id)
 
+	configuredLabels := plannedCommunity.Labels
 	convertToTerraformModel(ctx, &plannedCommunity, createdCommunity, &resp.Diagnostics)
+	r.stripDefaultLabels(ctx, &plannedCommunity, configuredLabels, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plannedCommunity)...)
 }
@@ -155,7 +159,9 @@ func (r *communityResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	configuredLabels := stateCommunity.Labels
 	convertToTerraformModel(ctx, &stateCommunity, existingCommunity, &resp.Diagnostics)
+	r.stripDefaultLabels(ctx, &stateCommunity, configuredLabels, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateCommunity)...)
 }
@@ -191,10 +197,10 @@ func (r *communityResource) Update(ctx context.Context, req resource.UpdateReque
 	if !plannedCommunity.Name.Equal(stateCommunity.Name) {
 		updatePaths = append(updatePaths, "name")
 	}
-	if !plannedCommunity.Labels.Equal(stateCommunity.Labels) {
+	if !plannedCommunity.EffectiveLabels.Equal(stateCommunity.EffectiveLabels) {
 		updatePaths = append(updatePaths, "labels")
-		labels := make(map[string]string, len(plannedCommunity.Labels.Elements()))
-		resp.Diagnostics.Append(plannedCommunity.Labels.ElementsAs(ctx, &labels, false)...)
+		labels := make(map[string]string, len(plannedCommunity.EffectiveLabels.Elements()))
+		resp.Diagnostics.Append(plannedCommunity.EffectiveLabels.ElementsAs(ctx, &labels, false)...)
 		updateCommunityRequest.SetLabels(labels)
 	}
 
@@ -259,7 +265,9 @@ func (r *communityResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Community was update with following parameters %+v", updatedCommunity))
+	configuredLabels := plannedCommunity.Labels
 	convertToTerraformModel(ctx, &plannedCommunity, updatedCommunity, &resp.Diagnostics)
+	r.stripDefaultLabels(ctx, &plannedCommunity, configuredLabels, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plannedCommunity)...)
 }
@@ -320,6 +328,20 @@ func (r *communityResource) Delete(ctx context.Context, req resource.DeleteReque
 
 }
 
+func (r *communityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerConfig == nil {
+		return
+	}
+	labels.ModifyPlan(ctx, r.providerConfig.ProviderState.DefaultLabels, req, resp)
+}
+
+// stripDefaultLabels removes the provider default labels from the community labels unless they are set in the configuration.
+func (r *communityResource) stripDefaultLabels(ctx context.Context, community *communityDataModel, configured types.Map, diags *diag.Diagnostics) {
+	stripped, d := labels.Strip(ctx, r.providerConfig.ProviderState.DefaultLabels, community.EffectiveLabels, configured)
+	diags.Append(d...)
+	community.Labels = stripped
+}
+
 func (r *communityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 
@@ -417,6 +439,7 @@ func (r *communityResource) Schema(ctx context.Context, _ resource.SchemaRequest
 					),
 				},
 			},
+			labels.EffectiveLabelsAttributeName: labels.EffectiveLabelsAttribute(),
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
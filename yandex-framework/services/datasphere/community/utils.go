@@ -21,4 +21,11 @@ func convertToTerraformModel(ctx context.Context, terraformModel *communityDataM
 	labels, diags := types.MapValueFrom(ctx, types.StringType, grpcModel.Labels)
 	terraformModel.Labels = labels
 	diag.Append(diags...)
+
+	effectiveLabels := make(map[string]string, len(grpcModel.Labels))
+	for k, v := range grpcModel.Labels {
+		effectiveLabels[k] = v
+	}
+	terraformModel.EffectiveLabels, diags = types.MapValueFrom(ctx, types.StringType, effectiveLabels)
+	diag.Append(diags...)
 }
@@ -8,3 +8,11 @@ import (
 func NewIamBinding() resource.Resource {
 	return accessbinding.NewIamBinding(newProjectIamUpdater())
 }
+
+func NewIamMember() resource.Resource {
+	return accessbinding.NewIamMember(newProjectIamUpdater())
+}
+
+func NewIamPolicy() resource.Resource {
+	return accessbinding.NewIamPolicy(newProjectIamUpdater())
+}
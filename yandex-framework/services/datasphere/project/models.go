@@ -7,16 +7,17 @@ import (
 )
 
 type projectDataModel struct {
-	Id          types.String   `tfsdk:"id"`
-	CreatedAt   types.String   `tfsdk:"created_at"`
-	Name        types.String   `tfsdk:"name"`
-	Description types.String   `tfsdk:"description"`
-	Labels      types.Map      `tfsdk:"labels"`
-	CreatedBy   types.String   `tfsdk:"created_by"`
-	Settings    types.Object   `tfsdk:"settings"`
-	Limits      types.Object   `tfsdk:"limits"`
-	CommunityId types.String   `tfsdk:"community_id"`
-	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+	Id              types.String   `tfsdk:"id"`
+	CreatedAt       types.String   `tfsdk:"created_at"`
+	Name            types.String   `tfsdk:"name"`
+	Description     types.String   `tfsdk:"description"`
+	Labels          types.Map      `tfsdk:"labels"`
+	EffectiveLabels types.Map      `tfsdk:"effective_labels"`
+	CreatedBy       types.String   `tfsdk:"created_by"`
+	Settings        types.Object   `tfsdk:"settings"`
+	Limits          types.Object   `tfsdk:"limits"`
+	CommunityId     types.String   `tfsdk:"community_id"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 type limitsObjectModel struct {
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/datasphere/v2"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/labels"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -54,9 +56,9 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		CommunityId: plannedProject.CommunityId.ValueString(),
 		Description: plannedProject.Description.ValueString(),
 	}
-	if !plannedProject.Labels.IsNull() && !plannedProject.Labels.IsUnknown() {
-		labels := make(map[string]string, len(plannedProject.Labels.Elements()))
-		resp.Diagnostics.Append(plannedProject.Labels.ElementsAs(ctx, &labels, false)...)
+	if !plannedProject.EffectiveLabels.IsNull() && !plannedProject.EffectiveLabels.IsUnknown() {
+		labels := make(map[string]string, len(plannedProject.EffectiveLabels.Elements()))
+		resp.Diagnostics.Append(plannedProject.EffectiveLabels.ElementsAs(ctx, &labels, false)...)
 		createProjectRequestData.SetLabels(labels)
 
 	}
@@ -198,7 +200,9 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	tflog.Info(ctx, fmt.Sprintf("Project with following id %s was created", createdProject.Id))
+	configuredLabels := plannedProject.Labels
 	convertToTerraformModel(ctx, &plannedProject, createdProject, &resp.Diagnostics, updatedBalance)
+	r.stripDefaultLabels(ctx, &plannedProject, configuredLabels, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plannedProject)...)
 }
@@ -235,7 +239,9 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	configuredLabels := stateProject.Labels
 	convertToTerraformModel(ctx, &stateProject, existingProject, &resp.Diagnostics, unitBalance.UnitBalance)
+	r.stripDefaultLabels(ctx, &stateProject, configuredLabels, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateProject)...)
 }
@@ -271,10 +277,10 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	if !planProject.Name.Equal(stateProject.Name) {
 		updatePaths = append(updatePaths, "name")
 	}
-	if !planProject.Labels.Equal(stateProject.Labels) {
+	if !planProject.EffectiveLabels.Equal(stateProject.EffectiveLabels) {
 		updatePaths = append(updatePaths, "labels")
-		labels := make(map[string]string, len(planProject.Labels.Elements()))
-		resp.Diagnostics.Append(planProject.Labels.ElementsAs(ctx, &labels, false)...)
+		labels := make(map[string]string, len(planProject.EffectiveLabels.Elements()))
+		resp.Diagnostics.Append(planProject.EffectiveLabels.ElementsAs(ctx, &labels, false)...)
 		updateProjectRequest.SetLabels(labels)
 	}
 	if !planProject.Settings.Equal(stateProject.Settings) {
@@ -447,7 +453,9 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 			updatedBalance,
 		),
 	)
+	configuredLabels := planProject.Labels
 	convertToTerraformModel(ctx, &planProject, updatedProject, &resp.Diagnostics, updatedBalance)
+	r.stripDefaultLabels(ctx, &planProject, configuredLabels, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &planProject)...)
 }
@@ -491,6 +499,20 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerConfig == nil {
+		return
+	}
+	labels.ModifyPlan(ctx, r.providerConfig.ProviderState.DefaultLabels, req, resp)
+}
+
+// stripDefaultLabels removes the provider default labels from the project labels unless they are set in the configuration.
+func (r *projectResource) stripDefaultLabels(ctx context.Context, project *projectDataModel, configured types.Map, diags *diag.Diagnostics) {
+	stripped, d := labels.Strip(ctx, r.providerConfig.ProviderState.DefaultLabels, project.EffectiveLabels, configured)
+	diags.Append(d...)
+	project.Labels = stripped
+}
+
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -581,6 +603,7 @@ func (r *projectResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 					),
 				},
 			},
+			labels.EffectiveLabelsAttributeName: labels.EffectiveLabelsAttribute(),
 			"settings": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"service_account_id": schema.StringAttribute{
@@ -23,6 +23,13 @@ func convertToTerraformModel(ctx context.Context, terraformModel *projectDataMod
 	terraformModel.Labels = labels
 	diag.Append(diags...)
 
+	effectiveLabels := make(map[string]string, len(grpcModel.Labels))
+	for k, v := range grpcModel.Labels {
+		effectiveLabels[k] = v
+	}
+	terraformModel.EffectiveLabels, diags = types.MapValueFrom(ctx, types.StringType, effectiveLabels)
+	diag.Append(diags...)
+
 	if grpcModel.Settings != nil {
 		var settings settingsObjectModel
 
@@ -0,0 +1,177 @@
+package serviceaccountkey
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"golang.org/x/exp/maps"
+
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+// privateKeyID is the private state key that keeps the ID of the issued key until Close is called.
+const privateKeyID = "key_id"
+
+type serviceAccountKeyEphemeralResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &serviceAccountKeyEphemeralResource{}
+}
+
+func (r *serviceAccountKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_service_account_key"
+}
+
+func (r *serviceAccountKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected EphemeralResource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *serviceAccountKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issues a service account key that lives only for the duration of a single Terraform run.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"service_account_id": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"format": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(maps.Keys(iam.KeyFormat_value)...),
+				},
+			},
+			"key_algorithm": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(maps.Keys(iam.Key_Algorithm_value)...),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Computed: true,
+			},
+			"private_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *serviceAccountKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var state ServiceAccountKey
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	format := valueOrDefault(state.Format, defaultFormat)
+	algorithm := valueOrDefault(state.KeyAlgorithm, defaultKeyAlgorithm)
+
+	key, err := r.providerConfig.SDK.IAM().Key().Create(ctx, &iam.CreateKeyRequest{
+		ServiceAccountId: state.ServiceAccountID.ValueString(),
+		Description:      state.Description.ValueString(),
+		Format:           iam.KeyFormat(iam.KeyFormat_value[format]),
+		KeyAlgorithm:     iam.Key_Algorithm(iam.Key_Algorithm_value[algorithm]),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Open ephemeral resource",
+			"Error while requesting API to create service account key:"+err.Error(),
+		)
+		return
+	}
+
+	// The key must not outlive the run: Close is not called if Open fails, so the key is deleted right away.
+	defer func() {
+		if resp.Diagnostics.HasError() {
+			r.deleteKey(ctx, key.GetKey().GetId(), &resp.Diagnostics, "Failed to Open ephemeral resource")
+		}
+	}()
+
+	keyID, err := json.Marshal(key.GetKey().GetId())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Open ephemeral resource",
+			"Error while saving service account key ID:"+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyID, keyID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyToState(key, &state)
+	state.Format = types.StringValue(format)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &state)...)
+}
+
+// Close revokes the key issued in Open, so it can not be used after the run is over.
+func (r *serviceAccountKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	data, diags := req.Private.GetKey(ctx, privateKeyID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || data == nil {
+		return
+	}
+
+	var keyID string
+	if err := json.Unmarshal(data, &keyID); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Close ephemeral resource",
+			"Error while reading service account key ID:"+err.Error(),
+		)
+		return
+	}
+
+	r.deleteKey(ctx, keyID, &resp.Diagnostics, "Failed to Close ephemeral resource")
+}
+
+func (r *serviceAccountKeyEphemeralResource) deleteKey(ctx context.Context, keyID string, diags *diag.Diagnostics, summary string) {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting ephemeral service account key %q", keyID))
+
+	_, err := r.providerConfig.SDK.IAM().Key().Delete(ctx, &iam.DeleteKeyRequest{
+		KeyId: keyID,
+	})
+	if err != nil {
+		diags.AddError(
+			summary,
+			"Error while requesting API to delete service account key:"+err.Error(),
+		)
+	}
+}
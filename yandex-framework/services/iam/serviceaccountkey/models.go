@@ -0,0 +1,43 @@
+package serviceaccountkey
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+)
+
+const (
+	defaultFormat       = "PEM_FILE"
+	defaultKeyAlgorithm = "RSA_2048"
+)
+
+type ServiceAccountKey struct {
+	Id               types.String `tfsdk:"id"`
+	ServiceAccountID types.String `tfsdk:"service_account_id"`
+	Description      types.String `tfsdk:"description"`
+	Format           types.String `tfsdk:"format"`
+	KeyAlgorithm     types.String `tfsdk:"key_algorithm"`
+	PublicKey        types.String `tfsdk:"public_key"`
+	PrivateKey       types.String `tfsdk:"private_key"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+}
+
+func keyToState(resp *iam.CreateKeyResponse, state *ServiceAccountKey) {
+	key := resp.GetKey()
+
+	state.Id = types.StringValue(key.GetId())
+	state.ServiceAccountID = types.StringValue(key.GetServiceAccountId())
+	state.Description = types.StringValue(key.GetDescription())
+	state.KeyAlgorithm = types.StringValue(key.GetKeyAlgorithm().String())
+	state.PublicKey = types.StringValue(key.GetPublicKey())
+	state.PrivateKey = types.StringValue(resp.GetPrivateKey())
+	state.CreatedAt = types.StringValue(key.GetCreatedAt().AsTime().Format(time.RFC3339))
+}
+
+func valueOrDefault(value types.String, defaultValue string) string {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return defaultValue
+	}
+	return value.ValueString()
+}
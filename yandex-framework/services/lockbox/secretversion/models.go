@@ -0,0 +1,54 @@
+package secretversion
+
+import (
+	"encoding/base64"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+)
+
+type SecretVersion struct {
+	SecretID  types.String `tfsdk:"secret_id"`
+	VersionID types.String `tfsdk:"version_id"`
+	Entries   types.List   `tfsdk:"entries"`
+}
+
+var entryType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"key":          types.StringType,
+		"text_value":   types.StringType,
+		"binary_value": types.StringType,
+	},
+}
+
+func payloadToState(payload *lockbox.Payload, state *SecretVersion) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	entries := make([]attr.Value, 0, len(payload.GetEntries()))
+	for _, entry := range payload.GetEntries() {
+		textValue, binaryValue := types.StringNull(), types.StringNull()
+		switch v := entry.GetValue().(type) {
+		case *lockbox.Payload_Entry_TextValue:
+			textValue = types.StringValue(v.TextValue)
+		case *lockbox.Payload_Entry_BinaryValue:
+			binaryValue = types.StringValue(base64.StdEncoding.EncodeToString(v.BinaryValue))
+		}
+
+		value, d := types.ObjectValue(entryType.AttrTypes, map[string]attr.Value{
+			"key":          types.StringValue(entry.GetKey()),
+			"text_value":   textValue,
+			"binary_value": binaryValue,
+		})
+		diags.Append(d...)
+		entries = append(entries, value)
+	}
+
+	value, d := types.ListValue(entryType, entries)
+	diags.Append(d...)
+
+	state.VersionID = types.StringValue(payload.GetVersionId())
+	state.Entries = value
+	return diags
+}
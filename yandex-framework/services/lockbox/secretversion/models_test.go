@@ -0,0 +1,39 @@
+package secretversion
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+)
+
+func TestPayloadToState(t *testing.T) {
+	payload := &lockbox.Payload{
+		VersionId: "version1",
+		Entries: []*lockbox.Payload_Entry{
+			{Key: "text", Value: &lockbox.Payload_Entry_TextValue{TextValue: "value"}},
+			{Key: "binary", Value: &lockbox.Payload_Entry_BinaryValue{BinaryValue: []byte{0x00, 0xff, 'a'}}},
+		},
+	}
+
+	var state SecretVersion
+	diags := payloadToState(payload, &state)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, "version1", state.VersionID.ValueString())
+	entries := state.Entries.Elements()
+	require.Len(t, entries, 2)
+	assert.Equal(t, map[string]attr.Value{
+		"key":          types.StringValue("text"),
+		"text_value":   types.StringValue("value"),
+		"binary_value": types.StringNull(),
+	}, entries[0].(types.Object).Attributes())
+	assert.Equal(t, map[string]attr.Value{
+		"key":          types.StringValue("binary"),
+		"text_value":   types.StringNull(),
+		"binary_value": types.StringValue("AP9h"),
+	}, entries[1].(types.Object).Attributes())
+}
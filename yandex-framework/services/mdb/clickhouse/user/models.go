@@ -0,0 +1,178 @@
+package user
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type User struct {
+	Id         types.String `tfsdk:"id"`
+	ClusterID  types.String `tfsdk:"cluster_id"`
+	Name       types.String `tfsdk:"name"`
+	Password   types.String `tfsdk:"password"`
+	Permission types.Set    `tfsdk:"permission"`
+	Quota      types.Set    `tfsdk:"quota"`
+	Settings   types.List   `tfsdk:"settings"`
+}
+
+// UserResource extends User with the write-only arguments that exist only in the resource schema.
+type UserResource struct {
+	User
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+type Permission struct {
+	DatabaseName types.String `tfsdk:"database_name"`
+}
+
+type Quota struct {
+	IntervalDuration types.Int64 `tfsdk:"interval_duration"`
+	Queries          types.Int64 `tfsdk:"queries"`
+	Errors           types.Int64 `tfsdk:"errors"`
+	ResultRows       types.Int64 `tfsdk:"result_rows"`
+	ReadRows         types.Int64 `tfsdk:"read_rows"`
+	ExecutionTime    types.Int64 `tfsdk:"execution_time"`
+}
+
+var permissionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"database_name": types.StringType,
+	},
+}
+
+var quotaType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"interval_duration": types.Int64Type,
+		"queries":           types.Int64Type,
+		"errors":            types.Int64Type,
+		"result_rows":       types.Int64Type,
+		"read_rows":         types.Int64Type,
+		"execution_time":    types.Int64Type,
+	},
+}
+
+func userToState(user *clickhouse.User, state *User) diag.Diagnostics {
+	state.Name = types.StringValue(user.Name)
+	state.ClusterID = types.StringValue(user.ClusterId)
+
+	var diags diag.Diagnostics
+	// The user without permissions has access to all databases, so the permissions granted
+	// by the API are not tracked unless they are configured explicitly.
+	if state.Permission.IsNull() || state.Permission.IsUnknown() || len(state.Permission.Elements()) != 0 {
+		diags.Append(permissionsToState(user.Permissions, state)...)
+	}
+	diags.Append(quotasToState(user.Quotas, state)...)
+	diags.Append(settingsToState(user.Settings, state)...)
+	return diags
+}
+
+func permissionsToState(permissions []*clickhouse.Permission, state *User) diag.Diagnostics {
+	var permissionValues []attr.Value
+
+	var diags diag.Diagnostics
+	for _, permission := range permissions {
+		permissionValue, diagnostics := types.ObjectValue(permissionType.AttrTypes, map[string]attr.Value{
+			"database_name": types.StringValue(permission.DatabaseName),
+		})
+
+		permissionValues = append(permissionValues, permissionValue)
+		diags.Append(diagnostics...)
+	}
+
+	value, diagnostics := types.SetValue(permissionType, permissionValues)
+	diags.Append(diagnostics...)
+
+	state.Permission = value
+	return diags
+}
+
+func quotasToState(quotas []*clickhouse.UserQuota, state *User) diag.Diagnostics {
+	var quotaValues []attr.Value
+
+	var diags diag.Diagnostics
+	for _, quota := range quotas {
+		quotaValue, diagnostics := types.ObjectValue(quotaType.AttrTypes, map[string]attr.Value{
+			"interval_duration": int64ToState(quota.IntervalDuration),
+			"queries":           int64ToState(quota.Queries),
+			"errors":            int64ToState(quota.Errors),
+			"result_rows":       int64ToState(quota.ResultRows),
+			"read_rows":         int64ToState(quota.ReadRows),
+			"execution_time":    int64ToState(quota.ExecutionTime),
+		})
+
+		quotaValues = append(quotaValues, quotaValue)
+		diags.Append(diagnostics...)
+	}
+
+	value, diagnostics := types.SetValue(quotaType, quotaValues)
+	diags.Append(diagnostics...)
+
+	state.Quota = value
+	return diags
+}
+
+func int64ToState(v *wrapperspb.Int64Value) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(v.GetValue())
+}
+
+func int64FromState(v types.Int64) *wrapperspb.Int64Value {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return wrapperspb.Int64(v.ValueInt64())
+}
+
+func userFromState(ctx context.Context, state *User) (*clickhouse.UserSpec, diag.Diagnostics) {
+	permissions, diags := permissionsFromState(ctx, state)
+	quotas, d := quotasFromState(ctx, state)
+	diags.Append(d...)
+	settings, d := settingsFromState(state)
+	diags.Append(d...)
+	return &clickhouse.UserSpec{
+		Name:        state.Name.ValueString(),
+		Password:    state.Password.ValueString(),
+		Permissions: permissions,
+		Quotas:      quotas,
+		Settings:    settings,
+	}, diags
+}
+
+func permissionsFromState(ctx context.Context, state *User) ([]*clickhouse.Permission, diag.Diagnostics) {
+	permissions := make([]*clickhouse.Permission, 0, len(state.Permission.Elements()))
+	permissionsType := make([]Permission, 0, len(state.Permission.Elements()))
+	diags := state.Permission.ElementsAs(ctx, &permissionsType, false)
+
+	for _, permission := range permissionsType {
+		permissions = append(permissions, &clickhouse.Permission{
+			DatabaseName: permission.DatabaseName.ValueString(),
+		})
+	}
+	return permissions, diags
+}
+
+func quotasFromState(ctx context.Context, state *User) ([]*clickhouse.UserQuota, diag.Diagnostics) {
+	quotas := make([]*clickhouse.UserQuota, 0, len(state.Quota.Elements()))
+	quotasType := make([]Quota, 0, len(state.Quota.Elements()))
+	diags := state.Quota.ElementsAs(ctx, &quotasType, false)
+
+	for _, quota := range quotasType {
+		quotas = append(quotas, &clickhouse.UserQuota{
+			IntervalDuration: int64FromState(quota.IntervalDuration),
+			Queries:          int64FromState(quota.Queries),
+			Errors:           int64FromState(quota.Errors),
+			ResultRows:       int64FromState(quota.ResultRows),
+			ReadRows:         int64FromState(quota.ReadRows),
+			ExecutionTime:    int64FromState(quota.ExecutionTime),
+		})
+	}
+	return quotas, diags
+}
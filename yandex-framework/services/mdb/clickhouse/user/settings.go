@@ -0,0 +1,257 @@
+package user
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// settingsAttributes are the attributes of the settings block, named as the fields of clickhouse.UserSettings.
+// They are the same as the settings of the user block of yandex_mdb_clickhouse_cluster.
+var settingsAttributes = []string{
+	"readonly", "allow_ddl", "insert_quorum", "connect_timeout", "receive_timeout", "send_timeout",
+	"insert_quorum_timeout", "select_sequential_consistency", "max_replica_delay_for_distributed_queries",
+	"fallback_to_stale_replicas_for_distributed_queries", "replication_alter_partitions_sync",
+	"distributed_product_mode", "distributed_aggregation_memory_efficient", "distributed_ddl_task_timeout",
+	"skip_unavailable_shards", "compile", "min_count_to_compile", "compile_expressions",
+	"min_count_to_compile_expression", "max_block_size", "min_insert_block_size_rows",
+	"min_insert_block_size_bytes", "max_insert_block_size", "min_bytes_to_use_direct_io",
+	"use_uncompressed_cache", "merge_tree_max_rows_to_use_cache", "merge_tree_max_bytes_to_use_cache",
+	"merge_tree_min_rows_for_concurrent_read", "merge_tree_min_bytes_for_concurrent_read",
+	"max_bytes_before_external_group_by", "max_bytes_before_external_sort", "group_by_two_level_threshold",
+	"group_by_two_level_threshold_bytes", "priority", "max_threads", "max_memory_usage",
+	"max_memory_usage_for_user", "max_network_bandwidth", "max_network_bandwidth_for_user",
+	"force_index_by_date", "force_primary_key", "max_rows_to_read", "max_bytes_to_read", "read_overflow_mode",
+	"max_rows_to_group_by", "group_by_overflow_mode", "max_rows_to_sort", "max_bytes_to_sort",
+	"sort_overflow_mode", "max_result_rows", "max_result_bytes", "result_overflow_mode", "max_rows_in_distinct",
+	"max_bytes_in_distinct", "distinct_overflow_mode", "max_rows_to_transfer", "max_bytes_to_transfer",
+	"transfer_overflow_mode", "max_execution_time", "timeout_overflow_mode", "max_rows_in_set",
+	"max_bytes_in_set", "set_overflow_mode", "max_rows_in_join", "max_bytes_in_join", "join_overflow_mode",
+	"max_columns_to_read", "max_temporary_columns", "max_temporary_non_const_columns", "max_query_size",
+	"max_ast_depth", "max_ast_elements", "max_expanded_ast_elements", "min_execution_speed",
+	"min_execution_speed_bytes", "count_distinct_implementation", "input_format_values_interpret_expressions",
+	"input_format_defaults_for_omitted_fields", "output_format_json_quote_64bit_integers",
+	"output_format_json_quote_denormals", "low_cardinality_allow_in_native_format",
+	"empty_result_for_aggregation_by_empty_set", "joined_subquery_requires_alias", "join_use_nulls",
+	"transform_null_in", "http_connection_timeout", "http_receive_timeout", "http_send_timeout",
+	"enable_http_compression", "send_progress_in_http_headers", "http_headers_progress_interval",
+	"add_http_cors_header", "quota_mode", "max_concurrent_queries_for_user", "memory_profiler_step",
+	"memory_profiler_sample_probability", "insert_null_as_default", "allow_suspicious_low_cardinality_types",
+	"connect_timeout_with_failover", "allow_introspection_functions", "async_insert", "async_insert_threads",
+	"wait_for_async_insert", "wait_for_async_insert_timeout", "async_insert_max_data_size",
+	"async_insert_busy_timeout", "async_insert_stale_timeout", "timeout_before_checking_execution_speed",
+	"cancel_http_readonly_queries_on_client_close", "flatten_nested", "max_http_get_redirects",
+	"input_format_import_nested_json", "input_format_parallel_parsing", "max_final_threads",
+	"max_read_buffer_size", "local_filesystem_read_method", "remote_filesystem_read_method",
+	"insert_keeper_max_retries", "max_temporary_data_on_disk_size_for_user",
+	"max_temporary_data_on_disk_size_for_query", "max_parser_depth", "memory_overcommit_ratio_denominator",
+	"memory_overcommit_ratio_denominator_for_user", "memory_usage_overcommit_max_wait_microseconds",
+}
+
+var (
+	settingsDescriptor = (&clickhouse.UserSettings{}).ProtoReflect().Descriptor()
+	settingsType       = types.ObjectType{AttrTypes: settingsAttrTypes()}
+)
+
+func settingsAttrTypes() map[string]attr.Type {
+	result := make(map[string]attr.Type, len(settingsAttributes))
+	for _, name := range settingsAttributes {
+		result[name] = settingAttrType(settingsDescriptor.Fields().ByName(protoreflect.Name(name)))
+	}
+	return result
+}
+
+// settingAttrType returns the type of the attribute of the setting: the wrappers are converted to their values
+// and the enums are converted to the lowercase names of their values.
+func settingAttrType(fd protoreflect.FieldDescriptor) attr.Type {
+	if fd.Kind() == protoreflect.EnumKind {
+		return types.StringType
+	}
+	switch fd.Message().FullName() {
+	case "google.protobuf.BoolValue":
+		return types.BoolType
+	case "google.protobuf.DoubleValue":
+		return types.Float64Type
+	default:
+		return types.Int64Type
+	}
+}
+
+// settingsResourceAttributes returns the attributes of the settings block of the resource.
+func settingsResourceAttributes() map[string]schema.Attribute {
+	result := make(map[string]schema.Attribute, len(settingsAttributes))
+	for _, name := range settingsAttributes {
+		fd := settingsDescriptor.Fields().ByName(protoreflect.Name(name))
+		switch settingAttrType(fd) {
+		case types.StringType:
+			result[name] = schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.OneOf(enumValueNames(fd.Enum())...)},
+			}
+		case types.BoolType:
+			result[name] = schema.BoolAttribute{Optional: true}
+		case types.Float64Type:
+			result[name] = schema.Float64Attribute{Optional: true}
+		default:
+			result[name] = schema.Int64Attribute{Optional: true}
+		}
+	}
+	return result
+}
+
+// settingsDataSourceAttributes returns the attributes of the settings block of the data source.
+func settingsDataSourceAttributes() map[string]datasourceschema.Attribute {
+	result := make(map[string]datasourceschema.Attribute, len(settingsAttributes))
+	for name, t := range settingsType.AttrTypes {
+		switch t {
+		case types.StringType:
+			result[name] = datasourceschema.StringAttribute{Computed: true}
+		case types.BoolType:
+			result[name] = datasourceschema.BoolAttribute{Computed: true}
+		case types.Float64Type:
+			result[name] = datasourceschema.Float64Attribute{Computed: true}
+		default:
+			result[name] = datasourceschema.Int64Attribute{Computed: true}
+		}
+	}
+	return result
+}
+
+// enumValueName returns the name of the enum value without the enum prefix in lowercase,
+// e.g. uniq_combined_64 for COUNT_DISTINCT_IMPLEMENTATION_UNIQ_COMBINED_64.
+func enumValueName(value protoreflect.EnumValueDescriptor) string {
+	prefix := strings.TrimSuffix(string(value.Parent().(protoreflect.EnumDescriptor).Values().ByNumber(0).Name()), "UNSPECIFIED")
+	return strings.ToLower(strings.TrimPrefix(string(value.Name()), prefix))
+}
+
+func enumValueNames(enum protoreflect.EnumDescriptor) []string {
+	var result []string
+	for i := 0; i < enum.Values().Len(); i++ {
+		if value := enum.Values().Get(i); value.Number() != 0 {
+			result = append(result, enumValueName(value))
+		}
+	}
+	return result
+}
+
+// settingsToState sets the settings of the user read from the API. Only the configured settings are tracked,
+// so that the settings changed outside of Terraform or by default do not produce a diff, while the imported user
+// and the data source get all the settings set in the API.
+func settingsToState(settings *clickhouse.UserSettings, state *User) diag.Diagnostics {
+	var configured map[string]attr.Value
+	if !state.Settings.IsNull() && !state.Settings.IsUnknown() {
+		if len(state.Settings.Elements()) == 0 {
+			return nil
+		}
+		configured = state.Settings.Elements()[0].(types.Object).Attributes()
+	} else if proto.Size(settings) == 0 {
+		state.Settings = types.ListValueMust(settingsType, []attr.Value{})
+		return nil
+	}
+
+	m := settings.ProtoReflect()
+	values := make(map[string]attr.Value, len(settingsAttributes))
+	for _, name := range settingsAttributes {
+		fd := settingsDescriptor.Fields().ByName(protoreflect.Name(name))
+		if configured != nil && configured[name].IsNull() {
+			values[name] = nullSetting(fd)
+			continue
+		}
+		values[name] = settingToState(m, fd)
+	}
+
+	value, diags := types.ObjectValue(settingsType.AttrTypes, values)
+	list, d := types.ListValue(settingsType, []attr.Value{value})
+	diags.Append(d...)
+	state.Settings = list
+	return diags
+}
+
+func settingToState(m protoreflect.Message, fd protoreflect.FieldDescriptor) attr.Value {
+	if fd.Kind() == protoreflect.EnumKind {
+		number := m.Get(fd).Enum()
+		if number == 0 {
+			return types.StringNull()
+		}
+		return types.StringValue(enumValueName(fd.Enum().Values().ByNumber(number)))
+	}
+	if !m.Has(fd) {
+		return nullSetting(fd)
+	}
+
+	wrapper := m.Get(fd).Message()
+	value := wrapper.Get(wrapper.Descriptor().Fields().ByName("value"))
+	switch settingAttrType(fd) {
+	case types.BoolType:
+		return types.BoolValue(value.Bool())
+	case types.Float64Type:
+		return types.Float64Value(value.Float())
+	default:
+		return types.Int64Value(value.Int())
+	}
+}
+
+func nullSetting(fd protoreflect.FieldDescriptor) attr.Value {
+	switch settingAttrType(fd) {
+	case types.StringType:
+		return types.StringNull()
+	case types.BoolType:
+		return types.BoolNull()
+	case types.Float64Type:
+		return types.Float64Null()
+	default:
+		return types.Int64Null()
+	}
+}
+
+// settingsFromState returns the configured settings of the user or nil if the settings block is not configured.
+func settingsFromState(state *User) (*clickhouse.UserSettings, diag.Diagnostics) {
+	if state.Settings.IsNull() || state.Settings.IsUnknown() || len(state.Settings.Elements()) == 0 {
+		return nil, nil
+	}
+
+	var diags diag.Diagnostics
+	settings := &clickhouse.UserSettings{}
+	m := settings.ProtoReflect()
+	for name, value := range state.Settings.Elements()[0].(types.Object).Attributes() {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		fd := settingsDescriptor.Fields().ByName(protoreflect.Name(name))
+		switch v := value.(type) {
+		case types.String:
+			enumValue := settingEnumValue(fd.Enum(), v.ValueString())
+			if enumValue == nil {
+				diags.AddError("Invalid ClickHouse user setting", "Unknown value "+v.ValueString()+" of setting "+name)
+				continue
+			}
+			m.Set(fd, protoreflect.ValueOfEnum(enumValue.Number()))
+		case types.Bool:
+			m.Set(fd, protoreflect.ValueOfMessage(wrapperspb.Bool(v.ValueBool()).ProtoReflect()))
+		case types.Float64:
+			m.Set(fd, protoreflect.ValueOfMessage(wrapperspb.Double(v.ValueFloat64()).ProtoReflect()))
+		case types.Int64:
+			m.Set(fd, protoreflect.ValueOfMessage(wrapperspb.Int64(v.ValueInt64()).ProtoReflect()))
+		}
+	}
+	return settings, diags
+}
+
+func settingEnumValue(enum protoreflect.EnumDescriptor, name string) protoreflect.EnumValueDescriptor {
+	for i := 0; i < enum.Values().Len(); i++ {
+		if value := enum.Values().Get(i); value.Number() != 0 && enumValueName(value) == name {
+			return value
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestSettingsAttributes(t *testing.T) {
+	for _, name := range settingsAttributes {
+		assert.NotNil(t, settingsDescriptor.Fields().ByName(protoreflect.Name(name)), "unknown setting %s", name)
+	}
+	assert.Len(t, settingsType.AttrTypes, len(settingsAttributes))
+
+	fd := settingsDescriptor.Fields().ByName("count_distinct_implementation")
+	assert.Equal(t, []string{"uniq", "uniq_combined", "uniq_combined_64", "uniq_hll_12", "uniq_exact"}, enumValueNames(fd.Enum()))
+}
+
+func TestSettingsRoundTrip(t *testing.T) {
+	settings := &clickhouse.UserSettings{
+		Readonly:                        wrapperspb.Int64(1),
+		AddHttpCorsHeader:               wrapperspb.Bool(true),
+		MaxMemoryUsage:                  wrapperspb.Int64(1000000000),
+		MemoryProfilerSampleProbability: wrapperspb.Double(0.5),
+		CountDistinctImplementation:     clickhouse.UserSettings_COUNT_DISTINCT_IMPLEMENTATION_UNIQ_HLL_12,
+	}
+
+	// The imported user gets all the settings set in the API.
+	var state User
+	diags := settingsToState(settings, &state)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Len(t, state.Settings.Elements(), 1)
+	values := state.Settings.Elements()[0].(types.Object).Attributes()
+	assert.Equal(t, types.Int64Value(1), values["readonly"])
+	assert.Equal(t, types.BoolValue(true), values["add_http_cors_header"])
+	assert.Equal(t, types.Float64Value(0.5), values["memory_profiler_sample_probability"])
+	assert.Equal(t, types.StringValue("uniq_hll_12"), values["count_distinct_implementation"])
+	assert.Equal(t, types.StringNull(), values["quota_mode"])
+	assert.Equal(t, types.Int64Null(), values["max_rows_to_read"])
+
+	result, diags := settingsFromState(&state)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.True(t, proto.Equal(settings, result), "%v", result)
+}
+
+func TestSettingsToStateConfigured(t *testing.T) {
+	settings := &clickhouse.UserSettings{
+		Readonly:       wrapperspb.Int64(2),
+		MaxMemoryUsage: wrapperspb.Int64(1000000000),
+	}
+
+	// The settings are not tracked unless the settings block is configured.
+	state := User{Settings: types.ListValueMust(settingsType, []attr.Value{})}
+	diags := settingsToState(settings, &state)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Empty(t, state.Settings.Elements())
+
+	// Only the configured settings are tracked.
+	values := make(map[string]attr.Value, len(settingsAttributes))
+	for name := range settingsType.AttrTypes {
+		values[name] = nullSetting(settingsDescriptor.Fields().ByName(protoreflect.Name(name)))
+	}
+	values["readonly"] = types.Int64Value(1)
+	state.Settings = types.ListValueMust(settingsType, []attr.Value{types.ObjectValueMust(settingsType.AttrTypes, values)})
+
+	diags = settingsToState(settings, &state)
+	require.False(t, diags.HasError(), "%v", diags)
+	values = state.Settings.Elements()[0].(types.Object).Attributes()
+	assert.Equal(t, types.Int64Value(2), values["readonly"])
+	assert.Equal(t, types.Int64Null(), values["max_memory_usage"])
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
 	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/retry"
 )
 
@@ -26,7 +27,7 @@ func readDatabase(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, c
 	return db
 }
 
-func createDatabase(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid, dbName string) {
+func createDatabase(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, private waiter.PrivateState, cid, dbName string) {
 	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
 		return sdk.MDB().MongoDB().Database().Create(ctx, &mongodb.CreateDatabaseRequest{
 			ClusterId: cid,
@@ -44,7 +45,14 @@ func createDatabase(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics,
 		return
 	}
 
-	if err = op.Wait(ctx); err != nil {
+	if err = waiter.Wait(ctx, op, private); err != nil {
+		if waiter.IsInterrupted(err) {
+			diag.AddWarning(
+				"Operation is still in progress",
+				"Waiting for operation to create MongoDB database will be resumed by the next Terraform run: "+err.Error(),
+			)
+			return
+		}
 		diag.AddError(
 			"Failed to Create resource",
 			"Error while waiting for operation to create MongoDB database:"+err.Error(),
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/resourceid"
 )
@@ -77,6 +78,11 @@ func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	// Wait for the operation interrupted on the previous run, if any.
+	if _, err := waiter.Resume(ctx, r.providerConfig.SDK, resp.Private); err != nil {
+		resp.Diagnostics.AddWarning("Failed to resume operation", err.Error())
+	}
+
 	cid := state.ClusterID.ValueString()
 	dbName := state.Name.ValueString()
 	db := readDatabase(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, dbName)
@@ -101,7 +107,7 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 
 	cid := plan.ClusterID.ValueString()
 	dbName := plan.Name.ValueString()
-	createDatabase(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, dbName)
+	createDatabase(ctx, r.providerConfig.SDK, &resp.Diagnostics, resp.Private, cid, dbName)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
 	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/retry"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
@@ -27,7 +28,7 @@ func readUser(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid s
 	return user
 }
 
-func createUser(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string, user *mongodb.UserSpec) {
+func createUser(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, private waiter.PrivateState, cid string, user *mongodb.UserSpec) {
 	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
 		return sdk.MDB().MongoDB().User().Create(ctx, &mongodb.CreateUserRequest{
 			ClusterId: cid,
@@ -43,7 +44,14 @@ func createUser(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid
 		return
 	}
 
-	if err = op.Wait(ctx); err != nil {
+	if err = waiter.Wait(ctx, op, private); err != nil {
+		if waiter.IsInterrupted(err) {
+			diag.AddWarning(
+				"Operation is still in progress",
+				"Waiting for operation to create MongoDB user will be resumed by the next Terraform run: "+err.Error(),
+			)
+			return
+		}
 		diag.AddError(
 			"Failed to Create resource",
 			"Error while waiting for operation to create MongoDB user:"+err.Error(),
@@ -51,7 +59,7 @@ func createUser(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid
 	}
 }
 
-func updateUser(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string, user *mongodb.UserSpec, updatePaths []string) {
+func updateUser(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, private waiter.PrivateState, cid string, user *mongodb.UserSpec, updatePaths []string) {
 	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
 		return sdk.MDB().MongoDB().User().Update(ctx, &mongodb.UpdateUserRequest{
 			ClusterId:   cid,
@@ -70,7 +78,14 @@ func updateUser(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid
 		return
 	}
 
-	if err = op.Wait(ctx); err != nil {
+	if err = waiter.Wait(ctx, op, private); err != nil {
+		if waiter.IsInterrupted(err) {
+			diag.AddWarning(
+				"Operation is still in progress",
+				"Waiting for operation to update MongoDB user will be resumed by the next Terraform run: "+err.Error(),
+			)
+			return
+		}
 		diag.AddError(
 			"Failed to Update resource",
 			"Error while waiting for operation to update MongoDB user:"+err.Error(),
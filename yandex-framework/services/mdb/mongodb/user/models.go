@@ -17,6 +17,13 @@ type User struct {
 	Permission types.Set    `tfsdk:"permission"`
 }
 
+// UserResource extends User with the write-only arguments that exist only in the resource schema.
+type UserResource struct {
+	User
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
 type Permission struct {
 	DatabaseName types.String `tfsdk:"database_name"`
 	Roles        types.Set    `tfsdk:"roles"`
@@ -4,13 +4,18 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/resourceid"
 )
@@ -68,8 +73,22 @@ func (r *bindingResource) Schema(_ context.Context,
 				},
 			},
 			"password": schema.StringAttribute{
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
 			},
 		},
 		Blocks: map[string]schema.Block{
@@ -91,19 +110,24 @@ func (r *bindingResource) Schema(_ context.Context,
 }
 
 func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state User
+	var state UserResource
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Wait for the operation interrupted on the previous run, if any.
+	if _, err := waiter.Resume(ctx, r.providerConfig.SDK, resp.Private); err != nil {
+		resp.Diagnostics.AddWarning("Failed to resume operation", err.Error())
+	}
+
 	cid := state.ClusterID.ValueString()
 	userName := state.Name.ValueString()
 	user := readUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, userName)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	resp.Diagnostics.Append(userToState(user, &state)...)
+	resp.Diagnostics.Append(userToState(user, &state.User)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -114,21 +138,22 @@ func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan User
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
+	var plan, config UserResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	cid := plan.ClusterID.ValueString()
-	userPlan, diags := userFromState(ctx, &plan)
+	userPlan, diags := userFromState(ctx, &plan.User)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	userPlan.Password = resolvePassword(&plan, &config)
 
-	createUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, userPlan)
+	createUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, resp.Private, cid, userPlan)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -138,9 +163,17 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 	resp.Diagnostics.Append(diags...)
 }
 
-func getUpdatePaths(plan, state *mongodb.UserSpec) []string {
+// resolvePassword prefers the write-only password, which is only available in the config.
+func resolvePassword(plan, config *UserResource) string {
+	if !config.PasswordWO.IsNull() {
+		return config.PasswordWO.ValueString()
+	}
+	return plan.Password.ValueString()
+}
+
+func getUpdatePaths(plan, state *mongodb.UserSpec, passwordWOChanged bool) []string {
 	var updatePaths []string
-	if state.Password != plan.Password {
+	if state.Password != plan.Password || passwordWOChanged {
 		updatePaths = append(updatePaths, "password")
 	}
 	if fmt.Sprintf("%v", state.Permissions) != fmt.Sprintf("%v", plan.Permissions) {
@@ -150,26 +183,30 @@ func getUpdatePaths(plan, state *mongodb.UserSpec) []string {
 }
 
 func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan User
-	var state User
+	var plan, state, config UserResource
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	cid := plan.ClusterID.ValueString()
-	userState, diags := userFromState(ctx, &state)
+	userState, diags := userFromState(ctx, &state.User)
 	resp.Diagnostics.Append(diags...)
-	userPlan, diags := userFromState(ctx, &plan)
+	userPlan, diags := userFromState(ctx, &plan.User)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	updatePaths := getUpdatePaths(userPlan, userState)
+
+	// The write-only password is never stored in the state, so its changes are tracked with password_wo_version.
+	passwordWOChanged := !plan.PasswordWOVersion.Equal(state.PasswordWOVersion)
+	updatePaths := getUpdatePaths(userPlan, userState, passwordWOChanged)
+	userPlan.Password = resolvePassword(&plan, &config)
 
 	if len(updatePaths) > 0 {
-		updateUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, userPlan, updatePaths)
+		updateUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, resp.Private, cid, userPlan, updatePaths)
 	}
 	if resp.Diagnostics.HasError() {
 		return
@@ -181,7 +218,7 @@ func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state User
+	var state UserResource
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -206,8 +243,8 @@ func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportSt
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	var state User
-	resp.Diagnostics.Append(userToState(user, &state)...)
+	var state UserResource
+	resp.Diagnostics.Append(userToState(user, &state.User)...)
 
 	diags := resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
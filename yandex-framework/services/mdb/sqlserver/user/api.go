@@ -0,0 +1,118 @@
+package user
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/retry"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func readUser(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string, userName string) *sqlserver.User {
+	user, err := sdk.MDB().SQLServer().User().Get(ctx, &sqlserver.GetUserRequest{
+		ClusterId: cid,
+		UserName:  userName,
+	})
+
+	if err != nil {
+		diag.AddError(
+			"Failed to Read resource",
+			"Error while requesting API to get SQLServer user:"+err.Error(),
+		)
+		return nil
+	}
+	return user
+}
+
+func createUser(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, private waiter.PrivateState, cid string, user *sqlserver.UserSpec) {
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		return sdk.MDB().SQLServer().User().Create(ctx, &sqlserver.CreateUserRequest{
+			ClusterId: cid,
+			UserSpec:  user,
+		})
+	})
+
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while requesting API to create SQLServer user:"+err.Error(),
+		)
+		return
+	}
+
+	if err = waiter.Wait(ctx, op, private); err != nil {
+		if waiter.IsInterrupted(err) {
+			diag.AddWarning(
+				"Operation is still in progress",
+				"Waiting for operation to create SQLServer user will be resumed by the next Terraform run: "+err.Error(),
+			)
+			return
+		}
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while waiting for operation to create SQLServer user:"+err.Error(),
+		)
+	}
+}
+
+func updateUser(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, private waiter.PrivateState, cid string, user *sqlserver.UserSpec, updatePaths []string) {
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		return sdk.MDB().SQLServer().User().Update(ctx, &sqlserver.UpdateUserRequest{
+			ClusterId:   cid,
+			UserName:    user.Name,
+			Password:    user.Password,
+			Permissions: user.Permissions,
+			UpdateMask:  &fieldmaskpb.FieldMask{Paths: updatePaths},
+		})
+	})
+
+	if err != nil {
+		diag.AddError(
+			"Failed to Update resource",
+			"Error while requesting API to update SQLServer user:"+err.Error(),
+		)
+		return
+	}
+
+	if err = waiter.Wait(ctx, op, private); err != nil {
+		if waiter.IsInterrupted(err) {
+			diag.AddWarning(
+				"Operation is still in progress",
+				"Waiting for operation to update SQLServer user will be resumed by the next Terraform run: "+err.Error(),
+			)
+			return
+		}
+		diag.AddError(
+			"Failed to Update resource",
+			"Error while waiting for operation to update SQLServer user:"+err.Error(),
+		)
+	}
+}
+
+func deleteUser(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid, userName string) {
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		return sdk.MDB().SQLServer().User().Delete(ctx, &sqlserver.DeleteUserRequest{
+			ClusterId: cid,
+			UserName:  userName,
+		})
+	})
+
+	if err != nil {
+		diag.AddError(
+			"Failed to Delete resource",
+			"Error while requesting API to delete SQLServer user:"+err.Error(),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to Delete resource",
+			"Error while waiting for operation to delete SQLServer user:"+err.Error(),
+		)
+	}
+}
@@ -0,0 +1,124 @@
+package user
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+)
+
+type User struct {
+	Id         types.String `tfsdk:"id"`
+	ClusterID  types.String `tfsdk:"cluster_id"`
+	Name       types.String `tfsdk:"name"`
+	Password   types.String `tfsdk:"password"`
+	Permission types.Set    `tfsdk:"permission"`
+}
+
+// UserResource extends User with the write-only arguments that exist only in the resource schema.
+type UserResource struct {
+	User
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+// rolesByName maps the database roles to the names used by the cluster resource, which omit the "DB_" prefix.
+var rolesByName = map[string]sqlserver.Permission_Role{
+	"OWNER":          sqlserver.Permission_DB_OWNER,
+	"SECURITYADMIN":  sqlserver.Permission_DB_SECURITYADMIN,
+	"ACCESSADMIN":    sqlserver.Permission_DB_ACCESSADMIN,
+	"BACKUPOPERATOR": sqlserver.Permission_DB_BACKUPOPERATOR,
+	"DDLADMIN":       sqlserver.Permission_DB_DDLADMIN,
+	"DATAWRITER":     sqlserver.Permission_DB_DATAWRITER,
+	"DATAREADER":     sqlserver.Permission_DB_DATAREADER,
+	"DENYDATAWRITER": sqlserver.Permission_DB_DENYDATAWRITER,
+	"DENYDATAREADER": sqlserver.Permission_DB_DENYDATAREADER,
+}
+
+var roleNames = func() map[sqlserver.Permission_Role]string {
+	names := make(map[sqlserver.Permission_Role]string, len(rolesByName))
+	for name, role := range rolesByName {
+		names[role] = name
+	}
+	return names
+}()
+
+type Permission struct {
+	DatabaseName types.String `tfsdk:"database_name"`
+	Roles        types.Set    `tfsdk:"roles"`
+}
+
+var permissionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"database_name": types.StringType,
+		"roles":         types.SetType{ElemType: types.StringType},
+	},
+}
+
+func userToState(user *sqlserver.User, state *User) diag.Diagnostics {
+	state.Name = types.StringValue(user.Name)
+	state.ClusterID = types.StringValue(user.ClusterId)
+
+	return permissionsToState(user.Permissions, state)
+}
+
+func permissionsToState(permissions []*sqlserver.Permission, state *User) diag.Diagnostics {
+	var permissionValues []attr.Value
+
+	var diags diag.Diagnostics
+	for _, permission := range permissions {
+		var stateRoles []attr.Value
+		for _, role := range permission.Roles {
+			stateRoles = append(stateRoles, types.StringValue(roleNames[role]))
+		}
+
+		value, diagnostics := types.SetValue(types.StringType, stateRoles)
+		diags.Append(diagnostics...)
+		permissionValue, diagnostics := types.ObjectValue(permissionType.AttrTypes, map[string]attr.Value{
+			"database_name": types.StringValue(permission.DatabaseName),
+			"roles":         value,
+		})
+
+		permissionValues = append(permissionValues, permissionValue)
+		diags.Append(diagnostics...)
+
+	}
+
+	value, diagnostics := types.SetValue(permissionType, permissionValues)
+	diags.Append(diagnostics...)
+
+	state.Permission = value
+	return diags
+}
+
+func userFromState(ctx context.Context, state *User) (*sqlserver.UserSpec, diag.Diagnostics) {
+	permissions, diags := permissionsFromState(ctx, state)
+	return &sqlserver.UserSpec{
+		Name:        state.Name.ValueString(),
+		Password:    state.Password.ValueString(),
+		Permissions: permissions,
+	}, diags
+}
+
+func permissionsFromState(ctx context.Context, state *User) ([]*sqlserver.Permission, diag.Diagnostics) {
+	permissions := make([]*sqlserver.Permission, 0, len(state.Permission.Elements()))
+	permissionsType := make([]Permission, 0, len(state.Permission.Elements()))
+	diags := state.Permission.ElementsAs(ctx, &permissionsType, false)
+
+	for _, permission := range permissionsType {
+		roleValues := make([]string, 0, len(permission.Roles.Elements()))
+		diags.Append(permission.Roles.ElementsAs(ctx, &roleValues, false)...)
+		roles := make([]sqlserver.Permission_Role, 0, len(roleValues))
+		for _, role := range roleValues {
+			roles = append(roles, rolesByName[role])
+		}
+
+		permissions = append(permissions, &sqlserver.Permission{
+			DatabaseName: permission.DatabaseName.ValueString(),
+			Roles:        roles,
+		})
+	}
+	return permissions, diags
+}
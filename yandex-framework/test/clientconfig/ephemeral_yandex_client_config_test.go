@@ -0,0 +1,45 @@
+package clientconfig
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+)
+
+const clientConfigEchoResourceName = "echo.test"
+
+func TestAccEphemeralClientConfig_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccEphemeralProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralClientConfigConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(clientConfigEchoResourceName, "data.folder_id", test.GetExampleFolderID()),
+					resource.TestCheckResourceAttrSet(clientConfigEchoResourceName, "data.iam_token"),
+					resource.TestCheckResourceAttrSet(clientConfigEchoResourceName, "data.expires_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEphemeralClientConfigConfig() string {
+	return `
+ephemeral "yandex_client_config" "test" {}
+
+provider "echo" {
+  data = ephemeral.yandex_client_config.test
+}
+
+resource "echo" "test" {}
+`
+}
@@ -0,0 +1,63 @@
+package iam
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+	dataspheretest "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test/datasphere"
+)
+
+func TestAccDatasphereProjectResourceIamMember(t *testing.T) {
+	var (
+		communityName = test.ResourceName(63)
+		projectName   = test.ResourceName(63)
+
+		userID = "allUsers"
+		role   = "datasphere.community-projects.viewer"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             dataspheretest.AccCheckProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasphereProjectIamMemberConfig(communityName, projectName, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					dataspheretest.ProjectExists(dataspheretest.ProjectResourceName),
+					testAccCheckDatasphereProjectIam(dataspheretest.ProjectResourceName, role, []string{"system:" + userID}),
+				),
+			},
+			{
+				ResourceName:                         "yandex_datasphere_project_iam_member.test-project-member",
+				ImportStateIdFunc:                    test.ImportIamMemberIdFunc(dataspheretest.ProjectResourceName, role, "system:"+userID),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project_id",
+			},
+		},
+	})
+}
+
+func testAccDatasphereProjectIamMemberConfig(communityName, projectName, role, userID string) string {
+	return fmt.Sprintf(`
+resource "yandex_datasphere_community" "test-community" {
+  name = "%s"
+  billing_account_id = "%s"
+  organization_id = "%s"
+}
+
+resource "yandex_datasphere_project_iam_member" "test-project-member" {
+  role = "%s"
+  member = "system:%s"
+  project_id = yandex_datasphere_project.test-project.id
+}
+
+resource "yandex_datasphere_project" "test-project" {
+  name = "%s"
+  community_id = yandex_datasphere_community.test-community.id
+}
+`, communityName, test.GetBillingAccountId(), test.GetExampleOrganizationID(), role, userID, projectName)
+}
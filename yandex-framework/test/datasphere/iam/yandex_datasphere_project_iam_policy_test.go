@@ -0,0 +1,94 @@
+package iam
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+	dataspheretest "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test/datasphere"
+)
+
+const projectIamPolicyResourceName = "yandex_datasphere_project_iam_policy.test-project-policy"
+
+func TestAccDatasphereProjectResourceIamPolicy(t *testing.T) {
+	var (
+		communityName = test.ResourceName(63)
+		projectName   = test.ResourceName(63)
+
+		role = "datasphere.community-projects.viewer"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             dataspheretest.AccCheckProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasphereProjectIamPolicyConfig(communityName, projectName, role, "system:allUsers"),
+				Check: resource.ComposeTestCheckFunc(
+					dataspheretest.ProjectExists(dataspheretest.ProjectResourceName),
+					testAccCheckDatasphereProjectIam(dataspheretest.ProjectResourceName, role, []string{"system:allUsers"}),
+				),
+			},
+			{
+				ResourceName:                         projectIamPolicyResourceName,
+				ImportStateIdFunc:                    test.ImportIamPolicyIdFunc(dataspheretest.ProjectResourceName),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project_id",
+			},
+			// Update the policy with another member of the role
+			{
+				Config: testAccDatasphereProjectIamPolicyConfig(communityName, projectName, role,
+					"system:allUsers", "system:allAuthenticatedUsers"),
+				Check: testAccCheckDatasphereProjectIam(dataspheretest.ProjectResourceName, role,
+					[]string{"system:allUsers", "system:allAuthenticatedUsers"}),
+			},
+			{
+				ResourceName:                         projectIamPolicyResourceName,
+				ImportStateIdFunc:                    test.ImportIamPolicyIdFunc(dataspheretest.ProjectResourceName),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project_id",
+			},
+			// Delete the policy, the access bindings of the project are removed
+			{
+				Config: testAccDatasphereProjectIamPolicyConfigProject(communityName, projectName),
+				Check:  testAccCheckDatasphereProjectIam(dataspheretest.ProjectResourceName, role, nil),
+			},
+		},
+	})
+}
+
+func testAccDatasphereProjectIamPolicyConfigProject(communityName, projectName string) string {
+	return fmt.Sprintf(`
+resource "yandex_datasphere_community" "test-community" {
+  name = "%s"
+  billing_account_id = "%s"
+  organization_id = "%s"
+}
+
+resource "yandex_datasphere_project" "test-project" {
+  name = "%s"
+  community_id = yandex_datasphere_community.test-community.id
+}
+`, communityName, test.GetBillingAccountId(), test.GetExampleOrganizationID(), projectName)
+}
+
+func testAccDatasphereProjectIamPolicyConfig(communityName, projectName, role string, members ...string) string {
+	return testAccDatasphereProjectIamPolicyConfigProject(communityName, projectName) + fmt.Sprintf(`
+data "yandex_iam_policy" "test-policy" {
+  binding {
+    role = "%s"
+    members = ["%s"]
+  }
+}
+
+resource "yandex_datasphere_project_iam_policy" "test-project-policy" {
+  policy_data = data.yandex_iam_policy.test-policy.policy_data
+  project_id = yandex_datasphere_project.test-project.id
+}
+`, role, strings.Join(members, `", "`))
+}
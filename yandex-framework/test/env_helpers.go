@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -17,19 +18,28 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
 	ycsdk "github.com/yandex-cloud/go-sdk"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/status"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/replay"
 )
 
 var AccProviders map[string]tfprotov6.ProviderServer
 var AccProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
 
+// AccEphemeralProviderFactories additionally contain the echo provider,
+// which is the only way to inspect values of ephemeral resources in tests.
+var AccEphemeralProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
+
 // WARNING!!!! do not use testAccProviderEmptyFolder in tests, that use testAccCheck***Destroy functions.
 // testAccCheck***Destroy functions tend to use static testAccProviderServer
 var testAccProviderEmptyFolder map[string]tfprotov6.ProviderServer
@@ -92,8 +102,18 @@ func init() {
 			return testAccProviderServer, nil
 		},
 	}
+	AccEphemeralProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+		"yandex": AccProviderFactories["yandex"],
+		"echo":   echoprovider.NewProviderServer(),
+	}
 
 	if os.Getenv("TF_ACC") != "" {
+		if _, err := fakecloud.StartIfEnabled(); err != nil {
+			panic(err)
+		}
+		if _, err := replay.StartIfEnabled(); err != nil {
+			panic(err)
+		}
 		if err := setTestIDs(); err != nil {
 			panic(err)
 		}
@@ -172,6 +192,7 @@ func setTestIDs() error {
 	if envEndpoint == "" {
 		envEndpoint = common.DefaultEndpoint
 	}
+	plaintext, _ := strconv.ParseBool(os.Getenv("YC_PLAINTEXT"))
 	ctx := context.Background()
 
 	providerConfig := &config.Config{
@@ -188,9 +209,20 @@ func setTestIDs() error {
 	config := &ycsdk.Config{
 		Credentials: credentials,
 		Endpoint:    envEndpoint,
+		Plaintext:   plaintext,
+	}
+
+	// Trace the calls to the file, so that they are recorded together with the calls of the provider.
+	var opts []grpc.DialOption
+	if traceFile := os.Getenv("YC_API_TRACE_FILE"); traceFile != "" {
+		traceInterceptor, err := logging.NewTraceFileInterceptor(traceFile)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.WithUnaryInterceptor(traceInterceptor))
 	}
 
-	sdk, err := ycsdk.Build(ctx, *config)
+	sdk, err := ycsdk.Build(ctx, *config, opts...)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,58 @@
+package iam
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+)
+
+const saKeyEchoResourceName = "echo.test"
+
+func TestAccEphemeralIAMServiceAccountKey_basic(t *testing.T) {
+	t.Parallel()
+	accountName := acctest.RandomWithPrefix("tf-sa-ephemeral")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccEphemeralProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralIAMServiceAccountKeyConfig(accountName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(saKeyEchoResourceName, "data.service_account_id", "yandex_iam_service_account.test", "id"),
+					resource.TestCheckResourceAttr(saKeyEchoResourceName, "data.format", "PEM_FILE"),
+					resource.TestCheckResourceAttr(saKeyEchoResourceName, "data.key_algorithm", "RSA_4096"),
+					resource.TestCheckResourceAttrSet(saKeyEchoResourceName, "data.private_key"),
+					resource.TestCheckResourceAttrSet(saKeyEchoResourceName, "data.public_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEphemeralIAMServiceAccountKeyConfig(accountName string) string {
+	return fmt.Sprintf(`
+resource "yandex_iam_service_account" "test" {
+  name = "%s"
+}
+
+ephemeral "yandex_iam_service_account_key" "test" {
+  service_account_id = yandex_iam_service_account.test.id
+  description        = "ephemeral key"
+  key_algorithm      = "RSA_4096"
+}
+
+provider "echo" {
+  data = ephemeral.yandex_iam_service_account_key.test
+}
+
+resource "echo" "test" {}
+`, accountName)
+}
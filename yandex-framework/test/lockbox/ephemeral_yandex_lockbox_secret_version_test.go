@@ -0,0 +1,65 @@
+package lockbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+)
+
+const lockboxEchoResourceName = "echo.test"
+
+func TestAccEphemeralLockboxSecretVersion_basic(t *testing.T) {
+	t.Parallel()
+	secretName := acctest.RandomWithPrefix("tf-lockbox-ephemeral")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccEphemeralProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralLockboxSecretVersionConfig(secretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(lockboxEchoResourceName, "data.version_id", "yandex_lockbox_secret_version.test", "id"),
+					resource.TestCheckResourceAttr(lockboxEchoResourceName, "data.entries.#", "1"),
+					resource.TestCheckResourceAttr(lockboxEchoResourceName, "data.entries.0.key", "key1"),
+					resource.TestCheckResourceAttr(lockboxEchoResourceName, "data.entries.0.text_value", "value1"),
+					resource.TestCheckNoResourceAttr(lockboxEchoResourceName, "data.entries.0.binary_value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEphemeralLockboxSecretVersionConfig(secretName string) string {
+	return fmt.Sprintf(`
+resource "yandex_lockbox_secret" "test" {
+  name = "%s"
+}
+
+resource "yandex_lockbox_secret_version" "test" {
+  secret_id = yandex_lockbox_secret.test.id
+  entries {
+    key        = "key1"
+    text_value = "value1"
+  }
+}
+
+ephemeral "yandex_lockbox_secret_version" "test" {
+  secret_id  = yandex_lockbox_secret.test.id
+  version_id = yandex_lockbox_secret_version.test.id
+}
+
+provider "echo" {
+  data = ephemeral.yandex_lockbox_secret_version.test
+}
+
+resource "echo" "test" {}
+`, secretName)
+}
@@ -0,0 +1,81 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+	chtpl "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test/mdb/clickhouse"
+)
+
+func TestAccDataSourceMDBClickHouseDatabase_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("ds-clickhouse-database")
+	description := "ClickHouse Database Terraform Datasource Test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBClickHouseDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMDBClickHouseDatabaseConfig(clusterName, description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.yandex_mdb_clickhouse_database.bar", "id",
+						"yandex_mdb_clickhouse_database.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.yandex_mdb_clickhouse_database.bar", "cluster_id",
+						"yandex_mdb_clickhouse_database.foo", "cluster_id"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_clickhouse_database.bar", "name", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMDBClickHouseDatabaseConfig(name string, description string) string {
+	return chtpl.ClusterConfig(name, description) + `
+resource "yandex_mdb_clickhouse_database" "foo" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "foo"
+}
+
+data "yandex_mdb_clickhouse_database" "bar" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = yandex_mdb_clickhouse_database.foo.name
+}
+`
+}
+
+func testAccCheckMDBClickHouseDatabaseDestroy(s *terraform.State) error {
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_clickhouse_database" {
+			continue
+		}
+
+		clusterId, dbname, err := resourceid.Deconstruct(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = config.SDK.MDB().Clickhouse().Database().Get(context.Background(), &clickhouse.GetDatabaseRequest{
+			ClusterId:    clusterId,
+			DatabaseName: dbname,
+		})
+
+		if err == nil {
+			return fmt.Errorf("ClickHouse Database still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+	chtpl "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test/mdb/clickhouse"
+)
+
+const (
+	chDatabaseResourceName  = "yandex_mdb_clickhouse_database.testdb"
+	chDatabaseResourceName1 = "yandex_mdb_clickhouse_database.testdb1"
+	chClusterResourceName   = "yandex_mdb_clickhouse_cluster.foo"
+)
+
+// Test that a ClickHouse Database can be created, replaced and destroyed
+func TestAccMDBClickHouseDatabase_full(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-clickhouse-database")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBClickHouseDatabaseConfigStep1(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(chDatabaseResourceName, "name", "testdb"),
+					testAccCheckMDBClickHouseClusterHasDatabase(t, "testdb"),
+				),
+			},
+			mdbClickHouseDatabaseImportStep(chDatabaseResourceName),
+			{
+				Config: testAccMDBClickHouseDatabaseConfigStep2(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(chDatabaseResourceName1, "name", "testdb1"),
+					resource.TestCheckResourceAttr(chDatabaseResourceName, "name", "testdb"),
+					testAccCheckMDBClickHouseClusterHasDatabase(t, "testdb1"),
+				),
+			},
+			mdbClickHouseDatabaseImportStep(chDatabaseResourceName1),
+		},
+	})
+}
+
+func mdbClickHouseDatabaseImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+	}
+}
+
+func testAccCheckMDBClickHouseClusterHasDatabase(t *testing.T, dbname string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[chClusterResourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found", chClusterResourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+		db, err := config.SDK.MDB().Clickhouse().Database().Get(context.Background(), &clickhouse.GetDatabaseRequest{
+			ClusterId:    rs.Primary.ID,
+			DatabaseName: dbname,
+		})
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, dbname, db.Name)
+		return nil
+	}
+}
+
+// Create database
+func testAccMDBClickHouseDatabaseConfigStep1(name string) string {
+	return chtpl.ClusterConfig(name, "ClickHouse Database Terraform Test") + `
+resource "yandex_mdb_clickhouse_database" "testdb" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "testdb"
+}
+`
+}
+
+// Create another database
+func testAccMDBClickHouseDatabaseConfigStep2(name string) string {
+	return testAccMDBClickHouseDatabaseConfigStep1(name) + `
+resource "yandex_mdb_clickhouse_database" "testdb1" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "testdb1"
+}
+`
+}
@@ -0,0 +1,94 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+	chtpl "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test/mdb/clickhouse"
+)
+
+func TestAccDataSourceMDBClickHouseUser_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("ds-clickhouse-user")
+	description := "ClickHouse User Terraform Datasource Test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBClickHouseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMDBClickHouseUserConfig(clusterName, description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.yandex_mdb_clickhouse_user.bar", "id",
+						"yandex_mdb_clickhouse_user.foo", "id"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_clickhouse_user.bar", "name", "bob"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_clickhouse_user.bar", "permission.#", "1"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_clickhouse_user.bar", "quota.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMDBClickHouseUserConfig(name string, description string) string {
+	return chtpl.ClusterConfig(name, description) + `
+resource "yandex_mdb_clickhouse_database" "foo" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "foo"
+}
+
+resource "yandex_mdb_clickhouse_user" "foo" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "bob"
+	password   = "mysecureP@ssw0rd"
+	permission {
+		database_name = yandex_mdb_clickhouse_database.foo.name
+	}
+	quota {
+		interval_duration = 3600000
+		queries           = 1000
+	}
+}
+
+data "yandex_mdb_clickhouse_user" "bar" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = yandex_mdb_clickhouse_user.foo.name
+}
+`
+}
+
+func testAccCheckMDBClickHouseUserDestroy(s *terraform.State) error {
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_clickhouse_user" {
+			continue
+		}
+
+		clusterId, userName, err := resourceid.Deconstruct(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = config.SDK.MDB().Clickhouse().User().Get(context.Background(), &clickhouse.GetUserRequest{
+			ClusterId: clusterId,
+			UserName:  userName,
+		})
+
+		if err == nil {
+			return fmt.Errorf("ClickHouse User still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+	chtpl "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test/mdb/clickhouse"
+)
+
+const (
+	chClusterResourceName   = "yandex_mdb_clickhouse_cluster.foo"
+	chUserResourceNameAlice = "yandex_mdb_clickhouse_user.alice"
+	chUserResourceNameBob   = "yandex_mdb_clickhouse_user.bob"
+)
+
+// Test that a ClickHouse User can be created, updated and destroyed
+func TestAccMDBClickHouseUser_full(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-clickhouse-user")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBClickHouseUserConfigStep1(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(chUserResourceNameAlice, "name", "alice"),
+					resource.TestCheckResourceAttr(chUserResourceNameAlice, "permission.#", "0"),
+				),
+			},
+			{
+				Config: testAccMDBClickHouseUserConfigStep2(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(chUserResourceNameBob, "name", "bob"),
+					testAccCheckMDBClickHouseUserHasPermissions(t, "bob", []string{"testdb"}),
+					resource.TestCheckResourceAttr(chUserResourceNameBob, "quota.#", "1"),
+					resource.TestCheckResourceAttr(chUserResourceNameBob, "settings.#", "1"),
+					resource.TestCheckResourceAttr(chUserResourceNameBob, "settings.0.max_memory_usage", "1000000000"),
+					resource.TestCheckResourceAttr(chUserResourceNameBob, "settings.0.count_distinct_implementation", "uniq_hll_12"),
+				),
+			},
+			mdbClickHouseUserImportStep(chUserResourceNameBob),
+			{
+				Config: testAccMDBClickHouseUserConfigStep3(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBClickHouseUserHasPermissions(t, "bob", []string{"testdb"}),
+					resource.TestCheckResourceAttr(chUserResourceNameBob, "quota.#", "0"),
+					resource.TestCheckResourceAttr(chUserResourceNameBob, "settings.#", "0"),
+				),
+			},
+			mdbClickHouseUserImportStep(chUserResourceNameBob),
+		},
+	})
+}
+
+func mdbClickHouseUserImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateVerifyIgnore: []string{
+			"password",            // password is not returned
+			"password_wo_version", // write-only password is never stored
+		},
+	}
+}
+
+func testAccCheckMDBClickHouseUserHasPermissions(t *testing.T, username string, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[chClusterResourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found", chClusterResourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+		user, err := config.SDK.MDB().Clickhouse().User().Get(context.Background(), &clickhouse.GetUserRequest{
+			ClusterId: rs.Primary.ID,
+			UserName:  username,
+		})
+		if err != nil {
+			return err
+		}
+
+		var actual []string
+		for _, permission := range user.Permissions {
+			actual = append(actual, permission.DatabaseName)
+		}
+		assert.ElementsMatch(t, expected, actual)
+		return nil
+	}
+}
+
+func testAccMDBClickHouseUserConfigStep0(name string) string {
+	return chtpl.ClusterConfig(name, "ClickHouse User Terraform Test") + `
+resource "yandex_mdb_clickhouse_database" "testdb" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "testdb"
+}
+`
+}
+
+// Create user with access to all databases
+func testAccMDBClickHouseUserConfigStep1(name string) string {
+	return testAccMDBClickHouseUserConfigStep0(name) + `
+resource "yandex_mdb_clickhouse_user" "alice" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "alice"
+	password   = "mysecureP@ssw0rd"
+}`
+}
+
+// Create another user with permission to the database, a quota and settings
+func testAccMDBClickHouseUserConfigStep2(name string) string {
+	return testAccMDBClickHouseUserConfigStep1(name) + `
+resource "yandex_mdb_clickhouse_user" "bob" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "bob"
+	password   = "mysecureP@ssw0rd"
+	permission {
+		database_name = yandex_mdb_clickhouse_database.testdb.name
+	}
+	quota {
+		interval_duration = 3600000
+		queries           = 1000
+		errors            = 100
+	}
+	settings {
+		max_memory_usage              = 1000000000
+		count_distinct_implementation = "uniq_hll_12"
+	}
+}`
+}
+
+// Drop Bob's quota and settings
+func testAccMDBClickHouseUserConfigStep3(name string) string {
+	return testAccMDBClickHouseUserConfigStep1(name) + `
+resource "yandex_mdb_clickhouse_user" "bob" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "bob"
+	password   = "mysecureP@ssw0rd"
+	permission {
+		database_name = yandex_mdb_clickhouse_database.testdb.name
+	}
+}`
+}
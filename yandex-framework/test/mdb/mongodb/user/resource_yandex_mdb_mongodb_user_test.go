@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/stretchr/testify/assert"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
 	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
@@ -66,13 +68,48 @@ func TestAccMDBMongoDBUser_full(t *testing.T) {
 	})
 }
 
+// Test that a MongoDB User password can be moved to the write-only argument and rotated with its version
+func TestAccMDBMongoDBUser_writeOnlyPassword(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-mongodb-user-wo")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBMongoDBUserConfigStep1(clusterName),
+				Check:  resource.TestCheckResourceAttr(mgUserResourceNameAlice, "name", "alice"),
+			},
+			{
+				Config: testAccMDBMongoDBUserConfigWriteOnly(clusterName, "mysecureP@ssw0rd", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(mgUserResourceNameAlice, "password"),
+					resource.TestCheckNoResourceAttr(mgUserResourceNameAlice, "password_wo"),
+					resource.TestCheckResourceAttr(mgUserResourceNameAlice, "password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccMDBMongoDBUserConfigWriteOnly(clusterName, "myNewSecureP@ssw0rd", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(mgUserResourceNameAlice, "password_wo"),
+					resource.TestCheckResourceAttr(mgUserResourceNameAlice, "password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func mdbMongoDBUserImportStep(name string) resource.TestStep {
 	return resource.TestStep{
 		ResourceName:      name,
 		ImportState:       true,
 		ImportStateVerify: true,
 		ImportStateVerifyIgnore: []string{
-			"password", // password is not returned
+			"password",            // password is not returned
+			"password_wo_version", // write-only password is never stored
 		},
 	}
 }
@@ -193,3 +230,14 @@ resource "yandex_mdb_mongodb_user" "alice" {
   	}
 }`
 }
+
+// Move Alice's password to the write-only argument
+func testAccMDBMongoDBUserConfigWriteOnly(name, password string, passwordVersion int) string {
+	return testAccMDBMongoDBUserConfigStep0(name) + fmt.Sprintf(`
+resource "yandex_mdb_mongodb_user" "alice" {
+	cluster_id          = yandex_mdb_mongodb_cluster.foo.id
+	name                = "alice"
+	password_wo         = "%s"
+	password_wo_version = %d
+}`, password, passwordVersion)
+}
@@ -0,0 +1,81 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+	sqltpl "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test/mdb/sqlserver"
+)
+
+func TestAccDataSourceMDBSQLServerDatabase_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("ds-sqlserver-database")
+	description := "SQLServer Database Terraform Datasource Test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBSQLServerDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMDBSQLServerDatabaseConfig(clusterName, description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.yandex_mdb_sqlserver_database.bar", "id",
+						"yandex_mdb_sqlserver_database.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.yandex_mdb_sqlserver_database.bar", "cluster_id",
+						"yandex_mdb_sqlserver_database.foo", "cluster_id"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_sqlserver_database.bar", "name", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMDBSQLServerDatabaseConfig(name string, description string) string {
+	return sqltpl.ClusterConfig(name, description) + `
+resource "yandex_mdb_sqlserver_database" "foo" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "foo"
+}
+
+data "yandex_mdb_sqlserver_database" "bar" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = yandex_mdb_sqlserver_database.foo.name
+}
+`
+}
+
+func testAccCheckMDBSQLServerDatabaseDestroy(s *terraform.State) error {
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_sqlserver_database" {
+			continue
+		}
+
+		clusterId, dbname, err := resourceid.Deconstruct(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = config.SDK.MDB().SQLServer().Database().Get(context.Background(), &sqlserver.GetDatabaseRequest{
+			ClusterId:    clusterId,
+			DatabaseName: dbname,
+		})
+
+		if err == nil {
+			return fmt.Errorf("SQLServer Database still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+	sqltpl "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test/mdb/sqlserver"
+)
+
+const (
+	sqlDatabaseResourceName  = "yandex_mdb_sqlserver_database.testdb"
+	sqlDatabaseResourceName1 = "yandex_mdb_sqlserver_database.testdb1"
+	sqlClusterResourceName   = "yandex_mdb_sqlserver_cluster.foo"
+)
+
+// Test that a SQLServer Database can be created, replaced and destroyed
+func TestAccMDBSQLServerDatabase_full(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-sqlserver-database")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBSQLServerDatabaseConfigStep1(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(sqlDatabaseResourceName, "name", "testdb"),
+					testAccCheckMDBSQLServerClusterHasDatabase(t, "testdb"),
+				),
+			},
+			mdbSQLServerDatabaseImportStep(sqlDatabaseResourceName),
+			{
+				Config: testAccMDBSQLServerDatabaseConfigStep2(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(sqlDatabaseResourceName1, "name", "testdb1"),
+					resource.TestCheckResourceAttr(sqlDatabaseResourceName, "name", "testdb"),
+					testAccCheckMDBSQLServerClusterHasDatabase(t, "testdb1"),
+				),
+			},
+			mdbSQLServerDatabaseImportStep(sqlDatabaseResourceName1),
+		},
+	})
+}
+
+func mdbSQLServerDatabaseImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+	}
+}
+
+func testAccCheckMDBSQLServerClusterHasDatabase(t *testing.T, dbname string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[sqlClusterResourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found", sqlClusterResourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+		db, err := config.SDK.MDB().SQLServer().Database().Get(context.Background(), &sqlserver.GetDatabaseRequest{
+			ClusterId:    rs.Primary.ID,
+			DatabaseName: dbname,
+		})
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, dbname, db.Name)
+		return nil
+	}
+}
+
+// Create database
+func testAccMDBSQLServerDatabaseConfigStep1(name string) string {
+	return sqltpl.ClusterConfig(name, "SQLServer Database Terraform Test") + `
+resource "yandex_mdb_sqlserver_database" "testdb" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "testdb"
+}
+`
+}
+
+// Create another database
+func testAccMDBSQLServerDatabaseConfigStep2(name string) string {
+	return testAccMDBSQLServerDatabaseConfigStep1(name) + `
+resource "yandex_mdb_sqlserver_database" "testdb1" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "testdb1"
+}
+`
+}
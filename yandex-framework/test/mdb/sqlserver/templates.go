@@ -0,0 +1,37 @@
+package sqlserver
+
+import "fmt"
+
+const VPCDependencies = `
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.1.0.0/24"]
+}
+`
+
+// ClusterConfig is the smallest SQL Server cluster to attach databases and users to.
+func ClusterConfig(name, description string) string {
+	return fmt.Sprintf(VPCDependencies+`
+resource "yandex_mdb_sqlserver_cluster" "foo" {
+	name        = "%s"
+	description = "%s"
+	environment = "PRESTABLE"
+	network_id  = yandex_vpc_network.foo.id
+	version     = "2016sp2ent"
+
+	resources {
+		resource_preset_id = "s2.small"
+		disk_size          = 10
+		disk_type_id       = "network-ssd"
+	}
+
+	host {
+		zone      = "ru-central1-a"
+		subnet_id = yandex_vpc_subnet.foo.id
+	}
+}
+`, name, description)
+}
@@ -0,0 +1,91 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+	sqltpl "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test/mdb/sqlserver"
+)
+
+func TestAccDataSourceMDBSQLServerUser_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("ds-sqlserver-user")
+	description := "SQLServer User Terraform Datasource Test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBSQLServerUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMDBSQLServerUserConfig(clusterName, description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.yandex_mdb_sqlserver_user.bar", "id",
+						"yandex_mdb_sqlserver_user.foo", "id"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_sqlserver_user.bar", "name", "bob"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_sqlserver_user.bar", "permission.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.yandex_mdb_sqlserver_user.bar", "permission.*.roles.*", "OWNER"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMDBSQLServerUserConfig(name string, description string) string {
+	return sqltpl.ClusterConfig(name, description) + `
+resource "yandex_mdb_sqlserver_database" "foo" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "foo"
+}
+
+resource "yandex_mdb_sqlserver_user" "foo" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "bob"
+	password   = "mysecureP@ssw0rd"
+	permission {
+		database_name = yandex_mdb_sqlserver_database.foo.name
+		roles         = ["OWNER"]
+	}
+}
+
+data "yandex_mdb_sqlserver_user" "bar" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = yandex_mdb_sqlserver_user.foo.name
+}
+`
+}
+
+func testAccCheckMDBSQLServerUserDestroy(s *terraform.State) error {
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_sqlserver_user" {
+			continue
+		}
+
+		clusterId, userName, err := resourceid.Deconstruct(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = config.SDK.MDB().SQLServer().User().Get(context.Background(), &sqlserver.GetUserRequest{
+			ClusterId: clusterId,
+			UserName:  userName,
+		})
+
+		if err == nil {
+			return fmt.Errorf("SQLServer User still exists")
+		}
+	}
+
+	return nil
+}
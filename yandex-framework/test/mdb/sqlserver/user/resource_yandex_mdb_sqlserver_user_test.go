@@ -0,0 +1,147 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+	sqltpl "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test/mdb/sqlserver"
+)
+
+const (
+	sqlClusterResourceName   = "yandex_mdb_sqlserver_cluster.foo"
+	sqlUserResourceNameAlice = "yandex_mdb_sqlserver_user.alice"
+	sqlUserResourceNameBob   = "yandex_mdb_sqlserver_user.bob"
+)
+
+// Test that a SQLServer User can be created, updated and destroyed
+func TestAccMDBSQLServerUser_full(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-sqlserver-user")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBSQLServerUserConfigStep1(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(sqlUserResourceNameAlice, "name", "alice"),
+					testAccCheckMDBSQLServerUserHasPermission(t, "alice", nil),
+				),
+			},
+			mdbSQLServerUserImportStep(sqlUserResourceNameAlice),
+			{
+				Config: testAccMDBSQLServerUserConfigStep2(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(sqlUserResourceNameBob, "name", "bob"),
+					testAccCheckMDBSQLServerUserHasPermission(t, "bob", map[string][]sqlserver.Permission_Role{
+						"testdb": {sqlserver.Permission_DB_OWNER, sqlserver.Permission_DB_DDLADMIN},
+					}),
+				),
+			},
+			mdbSQLServerUserImportStep(sqlUserResourceNameBob),
+			{
+				Config: testAccMDBSQLServerUserConfigStep3(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBSQLServerUserHasPermission(t, "alice", map[string][]sqlserver.Permission_Role{
+						"testdb": {sqlserver.Permission_DB_DATAREADER},
+					}),
+				),
+			},
+			mdbSQLServerUserImportStep(sqlUserResourceNameAlice),
+		},
+	})
+}
+
+func mdbSQLServerUserImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateVerifyIgnore: []string{
+			"password",            // password is not returned
+			"password_wo_version", // write-only password is never stored
+		},
+	}
+}
+
+func testAccCheckMDBSQLServerUserHasPermission(t *testing.T, username string, expected map[string][]sqlserver.Permission_Role) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[sqlClusterResourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found", sqlClusterResourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+		user, err := config.SDK.MDB().SQLServer().User().Get(context.Background(), &sqlserver.GetUserRequest{
+			ClusterId: rs.Primary.ID,
+			UserName:  username,
+		})
+		if err != nil {
+			return err
+		}
+
+		assert.Len(t, user.Permissions, len(expected))
+		for _, permission := range user.Permissions {
+			assert.ElementsMatch(t, expected[permission.DatabaseName], permission.Roles)
+		}
+		return nil
+	}
+}
+
+func testAccMDBSQLServerUserConfigStep0(name string) string {
+	return sqltpl.ClusterConfig(name, "SQLServer User Terraform Test") + `
+resource "yandex_mdb_sqlserver_database" "testdb" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "testdb"
+}
+`
+}
+
+// Create user without permissions
+func testAccMDBSQLServerUserConfigStep1(name string) string {
+	return testAccMDBSQLServerUserConfigStep0(name) + `
+resource "yandex_mdb_sqlserver_user" "alice" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "alice"
+	password   = "mysecureP@ssw0rd"
+}`
+}
+
+// Create another user and give permission to database
+func testAccMDBSQLServerUserConfigStep2(name string) string {
+	return testAccMDBSQLServerUserConfigStep1(name) + `
+resource "yandex_mdb_sqlserver_user" "bob" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "bob"
+	password   = "mysecureP@ssw0rd"
+	permission {
+		database_name = yandex_mdb_sqlserver_database.testdb.name
+		roles         = ["OWNER", "DDLADMIN"]
+	}
+}`
+}
+
+// Change Alice's permissions
+func testAccMDBSQLServerUserConfigStep3(name string) string {
+	return testAccMDBSQLServerUserConfigStep0(name) + `
+resource "yandex_mdb_sqlserver_user" "alice" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "alice"
+	password   = "mysecureP@ssw0rd"
+	permission {
+		database_name = yandex_mdb_sqlserver_database.testdb.name
+		roles         = ["DATAREADER"]
+	}
+}`
+}
@@ -41,3 +41,23 @@ func ImportIamBindingIdFunc(resourceName, role string) func(*terraform.State) (s
 	}
 
 }
+
+func ImportIamMemberIdFunc(resourceName, role, member string) func(*terraform.State) (string, error) {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("can't find %s in state", resourceName)
+		}
+		return fmt.Sprintf("%s,%s,%s", rs.Primary.ID, role, member), nil
+	}
+}
+
+func ImportIamPolicyIdFunc(resourceName string) func(*terraform.State) (string, error) {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("can't find %s in state", resourceName)
+		}
+		return rs.Primary.ID, nil
+	}
+}
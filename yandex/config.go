@@ -27,7 +27,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/impersonate"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/ratelimit"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sharedcredentials"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/wif"
 )
 
 const (
@@ -63,13 +67,29 @@ type Config struct {
 	SharedCredentialsFile string
 	Profile               string
 
+	// DefaultLabels are merged into the labels of every resource supporting them.
+	DefaultLabels map[string]string
+
+	// RateLimits are client-side limits of the API requests.
+	RateLimits []ratelimit.Limit
+
+	// APITraceFile is the file to trace the API calls to, if any.
+	APITraceFile string
+
+	// WorkloadIdentityFederation are the credentials exchanging an external JWT for an IAM token, if configured.
+	WorkloadIdentityFederation *wif.Credentials
+
+	// ImpersonateServiceAccountID is the service account to act as, with the Delegates in between, if any.
+	ImpersonateServiceAccountID        string
+	ImpersonateServiceAccountDelegates []string
+
 	// contextWithClientTraceID is a context that has client-trace-id in its metadata
 	// It is initialized from stopContext at the same time as ycsdk.SDK
 	contextWithClientTraceID context.Context
 
 	userAgent         string
 	sdk               *ycsdk.SDK
-	sharedCredentials *SharedCredentials
+	sharedCredentials *sharedcredentials.Credentials
 	defaultS3Session  *session.Session
 }
 
@@ -116,29 +136,54 @@ func (c *Config) initAndValidate(stopContext context.Context, terraformVersion s
 
 	retryInterceptor := retry.Interceptor(
 		retry.WithMax(c.MaxRetries),
-		retry.WithCodes(codes.Unavailable),
+		retry.WithCodes(codes.Unavailable, codes.ResourceExhausted),
 		retry.WithAttemptHeader(true),
 		retry.WithBackoff(backoffExponentialWithJitter(defaultExponentialBackoffBase, defaultExponentialBackoffCap)))
 
 	var interceptors = []grpc.UnaryClientInterceptor{
 		retryInterceptor,
-		requestIDInterceptor,
 	}
 
+	// Rate limit interceptor is below retry interceptor, so that every retry attempt is limited too.
+	if rateLimitInterceptor := ratelimit.NewInterceptor(c.RateLimits); rateLimitInterceptor != nil {
+		interceptors = append(interceptors, rateLimitInterceptor)
+	}
+	interceptors = append(interceptors, requestIDInterceptor)
+
 	// Support deep API logging in case user has requested it.
 	if os.Getenv("TF_ENABLE_API_LOGGING") != "" {
 		log.Print("[INFO] API logging has been requested, turning on")
 		interceptors = append(interceptors, logging.NewAPILoggingUnaryInterceptor())
 	}
 
+	// Trace interceptor is below id interceptor to record the request id of every attempt.
+	if c.APITraceFile != "" {
+		traceInterceptor, err := logging.NewTraceFileInterceptor(c.APITraceFile)
+		if err != nil {
+			return err
+		}
+		interceptors = append(interceptors, traceInterceptor)
+	}
+
 	// Make sure retry interceptor is above id interceptor.
 	// Now we will have new request id for every retry attempt.
 	interceptorChain := grpc_middleware.ChainUnaryClient(interceptors...)
 
-	c.sdk, err = ycsdk.Build(c.contextWithClientTraceID, *yandexSDKConfig,
+	dialOptions := []grpc.DialOption{
 		grpc.WithUserAgent(c.userAgent),
 		grpc.WithDefaultCallOptions(grpc.Header(&headerMD)),
-		grpc.WithUnaryInterceptor(interceptorChain))
+		grpc.WithUnaryInterceptor(interceptorChain),
+	}
+
+	if c.ImpersonateServiceAccountID != "" {
+		yandexSDKConfig.Credentials, err = impersonate.New(*yandexSDKConfig,
+			c.ImpersonateServiceAccountID, c.ImpersonateServiceAccountDelegates, dialOptions...)
+		if err != nil {
+			return err
+		}
+	}
+
+	c.sdk, err = ycsdk.Build(c.contextWithClientTraceID, *yandexSDKConfig, dialOptions...)
 	if err != nil {
 		return err
 	}
@@ -156,7 +201,7 @@ func (c *Config) initSharedCredentials() error {
 		return nil
 	}
 
-	sharedCredentialsProvider := SharedCredentialsProvider{c.SharedCredentialsFile, c.Profile}
+	sharedCredentialsProvider := sharedcredentials.Provider{Filename: c.SharedCredentialsFile, Profile: c.Profile}
 	sharedCredentials, err := sharedCredentialsProvider.Retrieve()
 	if err != nil {
 		return err
@@ -189,6 +234,10 @@ func (c *Config) initializeDefaultS3Client() (err error) {
 }
 
 func (c *Config) credentials() (ycsdk.Credentials, error) {
+	if c.WorkloadIdentityFederation != nil {
+		return c.WorkloadIdentityFederation, nil
+	}
+
 	if c.ServiceAccountKeyFileOrContent != "" {
 		contents, _, err := pathOrContents(c.ServiceAccountKeyFileOrContent)
 		if err != nil {
@@ -213,7 +262,7 @@ func (c *Config) credentials() (ycsdk.Credentials, error) {
 		return sa, nil
 	}
 
-	return nil, fmt.Errorf("one of 'token' or 'service_account_key_file' should be specified; if you are inside compute instance, you can attach service account to it in order to authenticate via instance service account")
+	return nil, fmt.Errorf("one of 'token', 'service_account_key_file' or 'workload_identity_federation' should be specified; if you are inside compute instance, you can attach service account to it in order to authenticate via instance service account")
 }
 
 func iamKeyFromJSONContent(content string) (*iamkey.Key, error) {
@@ -0,0 +1,248 @@
+package yandex
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+)
+
+const (
+	iamBindingLevelFolder       = "folder"
+	iamBindingLevelCloud        = "cloud"
+	iamBindingLevelOrganization = "organization"
+)
+
+// dataSourceYandexIAMEffectiveBindings returns the access bindings of the folder together with the ones
+// inherited from its cloud and organization. The data source can't read the state of other resources,
+// so the resources managing the bindings are described by `managed` blocks:
+//
+//	data "yandex_iam_effective_bindings" "audit" {
+//	  folder_id = "some_folder_id"
+//	  managed {
+//	    resource = "yandex_resourcemanager_folder_iam_binding.admins"
+//	    role     = yandex_resourcemanager_folder_iam_binding.admins.role
+//	    members  = yandex_resourcemanager_folder_iam_binding.admins.members
+//	  }
+//	}
+func dataSourceYandexIAMEffectiveBindings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceYandexIAMEffectiveBindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"folder_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"managed": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"level": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      iamBindingLevelFolder,
+							ValidateFunc: validation.StringInSlice([]string{iamBindingLevelFolder, iamBindingLevelCloud, iamBindingLevelOrganization}, false),
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"members": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateIamMember,
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+			"cloud_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bindings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"level": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"member": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type iamLevelUpdater struct {
+	level   string
+	updater ResourceIamUpdater
+}
+
+type iamEffectiveBinding struct {
+	level      string
+	resourceID string
+	role       string
+	member     string
+}
+
+type iamManagedBindings struct {
+	resource string
+	level    string
+	role     string
+	members  map[string]bool
+}
+
+func dataSourceYandexIAMEffectiveBindingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	folder, err := config.sdk.ResourceManager().Folder().Get(ctx, &resourcemanager.GetFolderRequest{
+		FolderId: folderID,
+	})
+	if err != nil {
+		return diag.Errorf("error reading folder %q: %s", folderID, err)
+	}
+
+	cloud, err := config.sdk.ResourceManager().Cloud().Get(ctx, &resourcemanager.GetCloudRequest{
+		CloudId: folder.CloudId,
+	})
+	if err != nil {
+		return diag.Errorf("error reading cloud %q: %s", folder.CloudId, err)
+	}
+
+	updaters := []iamLevelUpdater{
+		{iamBindingLevelFolder, &FolderIamUpdater{folderID: folderID, Config: config}},
+		{iamBindingLevelCloud, &CloudIamUpdater{cloudID: cloud.Id, Config: config}},
+	}
+	if cloud.OrganizationId != "" {
+		updaters = append(updaters, iamLevelUpdater{
+			iamBindingLevelOrganization, &OrganizationIamUpdater{organizationID: cloud.OrganizationId, Config: config},
+		})
+	}
+
+	role := d.Get("role").(string)
+	var bindings []iamEffectiveBinding
+	for _, u := range updaters {
+		policy, err := u.updater.GetResourceIamPolicy(ctx)
+		if err != nil {
+			return diag.Errorf("error reading access bindings of %s: %s", u.updater.DescribeResource(), err)
+		}
+		log.Printf("[DEBUG] Retrieved access bindings of %s: %+v", u.updater.DescribeResource(), policy)
+
+		for _, b := range policy.Bindings {
+			if role != "" && b.RoleId != role {
+				continue
+			}
+			bindings = append(bindings, iamEffectiveBinding{
+				level:      u.level,
+				resourceID: u.updater.GetResourceID(),
+				role:       b.RoleId,
+				member:     canonicalMember(b),
+			})
+		}
+	}
+
+	managed := expandIamManagedBindings(d.Get("managed").([]interface{}))
+	flattened := make([]map[string]interface{}, 0, len(bindings))
+	for _, b := range bindings {
+		flattened = append(flattened, map[string]interface{}{
+			"level":       b.level,
+			"resource_id": b.resourceID,
+			"role":        b.role,
+			"member":      b.member,
+			"owner":       iamBindingOwner(b, managed),
+		})
+	}
+
+	if err := d.Set("bindings", flattened); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("folder_id", folderID)
+	d.Set("cloud_id", cloud.Id)
+	d.Set("organization_id", cloud.OrganizationId)
+	d.SetId(folderID)
+
+	return nil
+}
+
+func expandIamManagedBindings(v []interface{}) []iamManagedBindings {
+	var result []iamManagedBindings
+	for _, raw := range v {
+		m := raw.(map[string]interface{})
+		managed := iamManagedBindings{
+			resource: m["resource"].(string),
+			level:    m["level"].(string),
+			role:     m["role"].(string),
+			members:  map[string]bool{},
+		}
+		if members, ok := m["members"].(*schema.Set); ok {
+			for _, member := range convertStringSet(members) {
+				managed.members[member] = true
+			}
+		}
+		result = append(result, managed)
+	}
+	return result
+}
+
+// iamBindingOwner Gets the first managed block describing the binding. The empty role or members
+// of the block match any role or member, like the `iam_policy` resources owning all bindings.
+func iamBindingOwner(b iamEffectiveBinding, managed []iamManagedBindings) string {
+	for _, m := range managed {
+		if m.level != b.level {
+			continue
+		}
+		if m.role != "" && m.role != b.role {
+			continue
+		}
+		if len(m.members) != 0 && !m.members[b.member] {
+			continue
+		}
+		return m.resource
+	}
+	return ""
+}
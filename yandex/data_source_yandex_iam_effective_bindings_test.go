@@ -0,0 +1,100 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDataSourceYandexIAMEffectiveBindings_basic(t *testing.T) {
+	folderID := getExampleFolderID()
+	userID1 := getExampleUserID1()
+	dsName := "data.yandex_iam_effective_bindings.audit"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceYandexIAMEffectiveBindings(folderID, userID1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "folder_id", folderID),
+					resource.TestCheckResourceAttrSet(dsName, "cloud_id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dsName, "bindings.*", map[string]string{
+						"level":       "folder",
+						"resource_id": folderID,
+						"role":        "viewer",
+						"member":      "userAccount:" + userID1,
+						"owner":       "yandex_resourcemanager_folder_iam_member.viewer",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceYandexIAMEffectiveBindings(folderID, userID string) string {
+	return fmt.Sprintf(`
+resource "yandex_resourcemanager_folder_iam_member" "viewer" {
+  folder_id = "%s"
+  role      = "viewer"
+  member    = "userAccount:%s"
+}
+
+data "yandex_iam_effective_bindings" "audit" {
+  folder_id = yandex_resourcemanager_folder_iam_member.viewer.folder_id
+  role      = "viewer"
+
+  managed {
+    resource = "yandex_resourcemanager_folder_iam_member.viewer"
+    role     = yandex_resourcemanager_folder_iam_member.viewer.role
+    members  = [yandex_resourcemanager_folder_iam_member.viewer.member]
+  }
+}
+`, folderID, userID)
+}
+
+func TestIamBindingOwner(t *testing.T) {
+	managed := []iamManagedBindings{
+		{resource: "yandex_resourcemanager_folder_iam_member.viewer", level: "folder", role: "viewer", members: map[string]bool{"userAccount:user1": true}},
+		{resource: "yandex_resourcemanager_folder_iam_binding.editors", level: "folder", role: "editor", members: map[string]bool{}},
+		{resource: "yandex_resourcemanager_cloud_iam_policy.cloud", level: "cloud", members: map[string]bool{}},
+	}
+
+	tests := []struct {
+		name    string
+		binding iamEffectiveBinding
+		want    string
+	}{
+		{
+			name:    "member",
+			binding: iamEffectiveBinding{level: "folder", role: "viewer", member: "userAccount:user1"},
+			want:    "yandex_resourcemanager_folder_iam_member.viewer",
+		},
+		{
+			name:    "not managed member of the role",
+			binding: iamEffectiveBinding{level: "folder", role: "viewer", member: "userAccount:user2"},
+		},
+		{
+			name:    "any member of the role",
+			binding: iamEffectiveBinding{level: "folder", role: "editor", member: "userAccount:user2"},
+			want:    "yandex_resourcemanager_folder_iam_binding.editors",
+		},
+		{
+			name:    "any role of the level",
+			binding: iamEffectiveBinding{level: "cloud", role: "admin", member: "userAccount:user2"},
+			want:    "yandex_resourcemanager_cloud_iam_policy.cloud",
+		},
+		{
+			name:    "not managed level",
+			binding: iamEffectiveBinding{level: "organization", role: "viewer", member: "userAccount:user1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, iamBindingOwner(tt.binding, managed))
+		})
+	}
+}
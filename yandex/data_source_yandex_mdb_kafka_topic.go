@@ -10,6 +10,8 @@ func dataSourceYandexMDBKafkaTopic() *schema.Resource {
 	dataSource.Schema["cluster_id"].Required = true
 	dataSource.Schema["name"].Computed = false
 	dataSource.Schema["name"].Required = true
+	delete(dataSource.Schema, "managed_config_keys")
+	delete(dataSource.Schema, "ignore_config_keys")
 	// TODO: SA1019: dataSource.Read is deprecated: Use ReadContext or ReadWithoutTimeout instead. This implementation does not support request cancellation initiated by Terraform, such as a system or practitioner sending SIGINT (Ctrl-c). This implementation also does not support warning diagnostics. (staticcheck)
 	dataSource.Read = dataSourceYandexMDBKafkaTopicRead
 	return dataSource
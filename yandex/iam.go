@@ -74,23 +74,15 @@ func iamPolicyReadModifySet(ctx context.Context, updater ResourceIamUpdater, mod
 	return nil
 }
 
+// iamPolicyReadModifyUpdate Applies the deltas together with the deltas of the concurrent calls for the same resource.
 func iamPolicyReadModifyUpdate(ctx context.Context, updater ResourceIamUpdater, policyDelta *PolicyDelta) error {
-	mutexKey := updater.GetMutexKey()
-	mutexKV.Lock(mutexKey)
-	defer mutexKV.Unlock(mutexKey)
-
-	log.Printf("[DEBUG]: Retrieving access bindings for %s\n", updater.DescribeResource())
-
-	p, err := updater.GetResourceIamPolicy(ctx)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("[DEBUG]: Retrieved access bindings for %s: %+v\n", updater.DescribeResource(), p)
-
 	log.Printf("[DEBUG]: Updating access bindings of %s with %+v\n", updater.DescribeResource(), policyDelta)
 
-	err = updater.UpdateResourceIamPolicy(ctx, policyDelta)
+	err := iamBatcher.Update(ctx, updater.GetMutexKey(), policyDelta.Deltas,
+		func(ctx context.Context, deltas []*access.AccessBindingDelta) error {
+			return updater.UpdateResourceIamPolicy(ctx, &PolicyDelta{Deltas: deltas})
+		},
+	)
 	if err != nil {
 		return fmt.Errorf("Error updating access bindings of %s: %w", updater.DescribeResource(), err)
 	}
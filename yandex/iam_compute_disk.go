@@ -0,0 +1,136 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+const yandexIAMComputeDiskDefaultTimeout = 1 * time.Minute
+const yandexIAMComputeDiskUpdateAccessBindingsBatchSize = 1000
+
+var IamComputeDiskSchema = map[string]*schema.Schema{
+	"disk_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+type ComputeDiskIamUpdater struct {
+	diskID string
+	Config *Config
+}
+
+func newComputeDiskIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	return &ComputeDiskIamUpdater{
+		diskID: d.Get("disk_id").(string),
+		Config: config,
+	}, nil
+}
+
+func computeDiskIDParseFunc(d *schema.ResourceData, _ *Config) error {
+	d.Set("disk_id", d.Id())
+	return nil
+}
+
+func (u *ComputeDiskIamUpdater) GetResourceIamPolicy(ctx context.Context) (*Policy, error) {
+	bindings, err := getComputeDiskAccessBindings(ctx, u.Config, u.GetResourceID())
+	if err != nil {
+		return nil, err
+	}
+	return &Policy{bindings}, nil
+}
+
+func (u *ComputeDiskIamUpdater) SetResourceIamPolicy(ctx context.Context, policy *Policy) error {
+	req := &access.SetAccessBindingsRequest{
+		ResourceId:     u.diskID,
+		AccessBindings: policy.Bindings,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMComputeDiskDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.Compute().Disk().SetAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error setting access bindings of %s: %w", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error setting access bindings of %s: %w", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
+func (u *ComputeDiskIamUpdater) UpdateResourceIamPolicy(ctx context.Context, policy *PolicyDelta) error {
+	bSize := yandexIAMComputeDiskUpdateAccessBindingsBatchSize
+	deltas := policy.Deltas
+	dLen := len(deltas)
+
+	for i := 0; i < countBatches(dLen, bSize); i++ {
+		req := &access.UpdateAccessBindingsRequest{
+			ResourceId:          u.diskID,
+			AccessBindingDeltas: deltas[i*bSize : min((i+1)*bSize, dLen)],
+		}
+
+		op, err := u.Config.sdk.WrapOperation(u.Config.sdk.Compute().Disk().UpdateAccessBindings(ctx, req))
+		if err != nil {
+			if reqID, ok := isRequestIDPresent(err); ok {
+				log.Printf("[DEBUG] request ID is %s\n", reqID)
+			}
+			return fmt.Errorf("Error updating access bindings of %s: %w", u.DescribeResource(), err)
+		}
+
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("Error updating access bindings of %s: %w", u.DescribeResource(), err)
+		}
+	}
+
+	return nil
+}
+
+func (u *ComputeDiskIamUpdater) GetResourceID() string {
+	return u.diskID
+}
+
+func (u *ComputeDiskIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("compute-disk-%s", u.diskID)
+}
+
+func (u *ComputeDiskIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("Compute Disk '%s'", u.diskID)
+}
+
+func getComputeDiskAccessBindings(ctx context.Context, config *Config, diskID string) ([]*access.AccessBinding, error) {
+	bindings := []*access.AccessBinding{}
+	pageToken := ""
+
+	for {
+		resp, err := config.sdk.Compute().Disk().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
+			ResourceId: diskID,
+			PageSize:   defaultListSize,
+			PageToken:  pageToken,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving access bindings of Compute Disk %s: %w", diskID, err)
+		}
+
+		bindings = append(bindings, resp.AccessBindings...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+
+		pageToken = resp.NextPageToken
+	}
+	return bindings, nil
+}
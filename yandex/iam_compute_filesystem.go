@@ -0,0 +1,136 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+const yandexIAMComputeFilesystemDefaultTimeout = 1 * time.Minute
+const yandexIAMComputeFilesystemUpdateAccessBindingsBatchSize = 1000
+
+var IamComputeFilesystemSchema = map[string]*schema.Schema{
+	"filesystem_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+type ComputeFilesystemIamUpdater struct {
+	filesystemID string
+	Config       *Config
+}
+
+func newComputeFilesystemIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	return &ComputeFilesystemIamUpdater{
+		filesystemID: d.Get("filesystem_id").(string),
+		Config:       config,
+	}, nil
+}
+
+func computeFilesystemIDParseFunc(d *schema.ResourceData, _ *Config) error {
+	d.Set("filesystem_id", d.Id())
+	return nil
+}
+
+func (u *ComputeFilesystemIamUpdater) GetResourceIamPolicy(ctx context.Context) (*Policy, error) {
+	bindings, err := getComputeFilesystemAccessBindings(ctx, u.Config, u.GetResourceID())
+	if err != nil {
+		return nil, err
+	}
+	return &Policy{bindings}, nil
+}
+
+func (u *ComputeFilesystemIamUpdater) SetResourceIamPolicy(ctx context.Context, policy *Policy) error {
+	req := &access.SetAccessBindingsRequest{
+		ResourceId:     u.filesystemID,
+		AccessBindings: policy.Bindings,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMComputeFilesystemDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.Compute().Filesystem().SetAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error setting access bindings of %s: %w", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error setting access bindings of %s: %w", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
+func (u *ComputeFilesystemIamUpdater) UpdateResourceIamPolicy(ctx context.Context, policy *PolicyDelta) error {
+	bSize := yandexIAMComputeFilesystemUpdateAccessBindingsBatchSize
+	deltas := policy.Deltas
+	dLen := len(deltas)
+
+	for i := 0; i < countBatches(dLen, bSize); i++ {
+		req := &access.UpdateAccessBindingsRequest{
+			ResourceId:          u.filesystemID,
+			AccessBindingDeltas: deltas[i*bSize : min((i+1)*bSize, dLen)],
+		}
+
+		op, err := u.Config.sdk.WrapOperation(u.Config.sdk.Compute().Filesystem().UpdateAccessBindings(ctx, req))
+		if err != nil {
+			if reqID, ok := isRequestIDPresent(err); ok {
+				log.Printf("[DEBUG] request ID is %s\n", reqID)
+			}
+			return fmt.Errorf("Error updating access bindings of %s: %w", u.DescribeResource(), err)
+		}
+
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("Error updating access bindings of %s: %w", u.DescribeResource(), err)
+		}
+	}
+
+	return nil
+}
+
+func (u *ComputeFilesystemIamUpdater) GetResourceID() string {
+	return u.filesystemID
+}
+
+func (u *ComputeFilesystemIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("compute-filesystem-%s", u.filesystemID)
+}
+
+func (u *ComputeFilesystemIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("Compute Filesystem '%s'", u.filesystemID)
+}
+
+func getComputeFilesystemAccessBindings(ctx context.Context, config *Config, filesystemID string) ([]*access.AccessBinding, error) {
+	bindings := []*access.AccessBinding{}
+	pageToken := ""
+
+	for {
+		resp, err := config.sdk.Compute().Filesystem().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
+			ResourceId: filesystemID,
+			PageSize:   defaultListSize,
+			PageToken:  pageToken,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving access bindings of Compute Filesystem %s: %w", filesystemID, err)
+		}
+
+		bindings = append(bindings, resp.AccessBindings...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+
+		pageToken = resp.NextPageToken
+	}
+	return bindings, nil
+}
@@ -0,0 +1,136 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+const yandexIAMComputeImageDefaultTimeout = 1 * time.Minute
+const yandexIAMComputeImageUpdateAccessBindingsBatchSize = 1000
+
+var IamComputeImageSchema = map[string]*schema.Schema{
+	"image_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+type ComputeImageIamUpdater struct {
+	imageID string
+	Config  *Config
+}
+
+func newComputeImageIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	return &ComputeImageIamUpdater{
+		imageID: d.Get("image_id").(string),
+		Config:  config,
+	}, nil
+}
+
+func computeImageIDParseFunc(d *schema.ResourceData, _ *Config) error {
+	d.Set("image_id", d.Id())
+	return nil
+}
+
+func (u *ComputeImageIamUpdater) GetResourceIamPolicy(ctx context.Context) (*Policy, error) {
+	bindings, err := getComputeImageAccessBindings(ctx, u.Config, u.GetResourceID())
+	if err != nil {
+		return nil, err
+	}
+	return &Policy{bindings}, nil
+}
+
+func (u *ComputeImageIamUpdater) SetResourceIamPolicy(ctx context.Context, policy *Policy) error {
+	req := &access.SetAccessBindingsRequest{
+		ResourceId:     u.imageID,
+		AccessBindings: policy.Bindings,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMComputeImageDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.Compute().Image().SetAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error setting access bindings of %s: %w", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error setting access bindings of %s: %w", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
+func (u *ComputeImageIamUpdater) UpdateResourceIamPolicy(ctx context.Context, policy *PolicyDelta) error {
+	bSize := yandexIAMComputeImageUpdateAccessBindingsBatchSize
+	deltas := policy.Deltas
+	dLen := len(deltas)
+
+	for i := 0; i < countBatches(dLen, bSize); i++ {
+		req := &access.UpdateAccessBindingsRequest{
+			ResourceId:          u.imageID,
+			AccessBindingDeltas: deltas[i*bSize : min((i+1)*bSize, dLen)],
+		}
+
+		op, err := u.Config.sdk.WrapOperation(u.Config.sdk.Compute().Image().UpdateAccessBindings(ctx, req))
+		if err != nil {
+			if reqID, ok := isRequestIDPresent(err); ok {
+				log.Printf("[DEBUG] request ID is %s\n", reqID)
+			}
+			return fmt.Errorf("Error updating access bindings of %s: %w", u.DescribeResource(), err)
+		}
+
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("Error updating access bindings of %s: %w", u.DescribeResource(), err)
+		}
+	}
+
+	return nil
+}
+
+func (u *ComputeImageIamUpdater) GetResourceID() string {
+	return u.imageID
+}
+
+func (u *ComputeImageIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("compute-image-%s", u.imageID)
+}
+
+func (u *ComputeImageIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("Compute Image '%s'", u.imageID)
+}
+
+func getComputeImageAccessBindings(ctx context.Context, config *Config, imageID string) ([]*access.AccessBinding, error) {
+	bindings := []*access.AccessBinding{}
+	pageToken := ""
+
+	for {
+		resp, err := config.sdk.Compute().Image().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
+			ResourceId: imageID,
+			PageSize:   defaultListSize,
+			PageToken:  pageToken,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving access bindings of Compute Image %s: %w", imageID, err)
+		}
+
+		bindings = append(bindings, resp.AccessBindings...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+
+		pageToken = resp.NextPageToken
+	}
+	return bindings, nil
+}
@@ -0,0 +1,136 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+const yandexIAMComputeInstanceDefaultTimeout = 1 * time.Minute
+const yandexIAMComputeInstanceUpdateAccessBindingsBatchSize = 1000
+
+var IamComputeInstanceSchema = map[string]*schema.Schema{
+	"instance_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+type ComputeInstanceIamUpdater struct {
+	instanceID string
+	Config     *Config
+}
+
+func newComputeInstanceIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	return &ComputeInstanceIamUpdater{
+		instanceID: d.Get("instance_id").(string),
+		Config:     config,
+	}, nil
+}
+
+func computeInstanceIDParseFunc(d *schema.ResourceData, _ *Config) error {
+	d.Set("instance_id", d.Id())
+	return nil
+}
+
+func (u *ComputeInstanceIamUpdater) GetResourceIamPolicy(ctx context.Context) (*Policy, error) {
+	bindings, err := getComputeInstanceAccessBindings(ctx, u.Config, u.GetResourceID())
+	if err != nil {
+		return nil, err
+	}
+	return &Policy{bindings}, nil
+}
+
+func (u *ComputeInstanceIamUpdater) SetResourceIamPolicy(ctx context.Context, policy *Policy) error {
+	req := &access.SetAccessBindingsRequest{
+		ResourceId:     u.instanceID,
+		AccessBindings: policy.Bindings,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMComputeInstanceDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.Compute().Instance().SetAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error setting access bindings of %s: %w", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error setting access bindings of %s: %w", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
+func (u *ComputeInstanceIamUpdater) UpdateResourceIamPolicy(ctx context.Context, policy *PolicyDelta) error {
+	bSize := yandexIAMComputeInstanceUpdateAccessBindingsBatchSize
+	deltas := policy.Deltas
+	dLen := len(deltas)
+
+	for i := 0; i < countBatches(dLen, bSize); i++ {
+		req := &access.UpdateAccessBindingsRequest{
+			ResourceId:          u.instanceID,
+			AccessBindingDeltas: deltas[i*bSize : min((i+1)*bSize, dLen)],
+		}
+
+		op, err := u.Config.sdk.WrapOperation(u.Config.sdk.Compute().Instance().UpdateAccessBindings(ctx, req))
+		if err != nil {
+			if reqID, ok := isRequestIDPresent(err); ok {
+				log.Printf("[DEBUG] request ID is %s\n", reqID)
+			}
+			return fmt.Errorf("Error updating access bindings of %s: %w", u.DescribeResource(), err)
+		}
+
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("Error updating access bindings of %s: %w", u.DescribeResource(), err)
+		}
+	}
+
+	return nil
+}
+
+func (u *ComputeInstanceIamUpdater) GetResourceID() string {
+	return u.instanceID
+}
+
+func (u *ComputeInstanceIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("compute-instance-%s", u.instanceID)
+}
+
+func (u *ComputeInstanceIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("Compute Instance '%s'", u.instanceID)
+}
+
+func getComputeInstanceAccessBindings(ctx context.Context, config *Config, instanceID string) ([]*access.AccessBinding, error) {
+	bindings := []*access.AccessBinding{}
+	pageToken := ""
+
+	for {
+		resp, err := config.sdk.Compute().Instance().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
+			ResourceId: instanceID,
+			PageSize:   defaultListSize,
+			PageToken:  pageToken,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving access bindings of Compute Instance %s: %w", instanceID, err)
+		}
+
+		bindings = append(bindings, resp.AccessBindings...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+
+		pageToken = resp.NextPageToken
+	}
+	return bindings, nil
+}
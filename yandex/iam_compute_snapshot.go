@@ -0,0 +1,136 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+const yandexIAMComputeSnapshotDefaultTimeout = 1 * time.Minute
+const yandexIAMComputeSnapshotUpdateAccessBindingsBatchSize = 1000
+
+var IamComputeSnapshotSchema = map[string]*schema.Schema{
+	"snapshot_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+type ComputeSnapshotIamUpdater struct {
+	snapshotID string
+	Config     *Config
+}
+
+func newComputeSnapshotIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	return &ComputeSnapshotIamUpdater{
+		snapshotID: d.Get("snapshot_id").(string),
+		Config:     config,
+	}, nil
+}
+
+func computeSnapshotIDParseFunc(d *schema.ResourceData, _ *Config) error {
+	d.Set("snapshot_id", d.Id())
+	return nil
+}
+
+func (u *ComputeSnapshotIamUpdater) GetResourceIamPolicy(ctx context.Context) (*Policy, error) {
+	bindings, err := getComputeSnapshotAccessBindings(ctx, u.Config, u.GetResourceID())
+	if err != nil {
+		return nil, err
+	}
+	return &Policy{bindings}, nil
+}
+
+func (u *ComputeSnapshotIamUpdater) SetResourceIamPolicy(ctx context.Context, policy *Policy) error {
+	req := &access.SetAccessBindingsRequest{
+		ResourceId:     u.snapshotID,
+		AccessBindings: policy.Bindings,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, yandexIAMComputeSnapshotDefaultTimeout)
+	defer cancel()
+
+	op, err := u.Config.sdk.WrapOperation(u.Config.sdk.Compute().Snapshot().SetAccessBindings(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error setting access bindings of %s: %w", u.DescribeResource(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error setting access bindings of %s: %w", u.DescribeResource(), err)
+	}
+
+	return nil
+}
+
+func (u *ComputeSnapshotIamUpdater) UpdateResourceIamPolicy(ctx context.Context, policy *PolicyDelta) error {
+	bSize := yandexIAMComputeSnapshotUpdateAccessBindingsBatchSize
+	deltas := policy.Deltas
+	dLen := len(deltas)
+
+	for i := 0; i < countBatches(dLen, bSize); i++ {
+		req := &access.UpdateAccessBindingsRequest{
+			ResourceId:          u.snapshotID,
+			AccessBindingDeltas: deltas[i*bSize : min((i+1)*bSize, dLen)],
+		}
+
+		op, err := u.Config.sdk.WrapOperation(u.Config.sdk.Compute().Snapshot().UpdateAccessBindings(ctx, req))
+		if err != nil {
+			if reqID, ok := isRequestIDPresent(err); ok {
+				log.Printf("[DEBUG] request ID is %s\n", reqID)
+			}
+			return fmt.Errorf("Error updating access bindings of %s: %w", u.DescribeResource(), err)
+		}
+
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("Error updating access bindings of %s: %w", u.DescribeResource(), err)
+		}
+	}
+
+	return nil
+}
+
+func (u *ComputeSnapshotIamUpdater) GetResourceID() string {
+	return u.snapshotID
+}
+
+func (u *ComputeSnapshotIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("compute-snapshot-%s", u.snapshotID)
+}
+
+func (u *ComputeSnapshotIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("Compute Snapshot '%s'", u.snapshotID)
+}
+
+func getComputeSnapshotAccessBindings(ctx context.Context, config *Config, snapshotID string) ([]*access.AccessBinding, error) {
+	bindings := []*access.AccessBinding{}
+	pageToken := ""
+
+	for {
+		resp, err := config.sdk.Compute().Snapshot().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
+			ResourceId: snapshotID,
+			PageSize:   defaultListSize,
+			PageToken:  pageToken,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving access bindings of Compute Snapshot %s: %w", snapshotID, err)
+		}
+
+		bindings = append(bindings, resp.AccessBindings...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+
+		pageToken = resp.NextPageToken
+	}
+	return bindings, nil
+}
@@ -2,7 +2,12 @@ package yandex
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
 )
 
@@ -33,3 +38,90 @@ func (tm *KafkaTopicManager) DeleteKafkaTopic(ctx context.Context, d *schema.Res
 func (tm *KafkaTopicManager) UpdateKafkaTopic(ctx context.Context, d *schema.ResourceData, topicSpec *kafka.TopicSpec, paths []string) error {
 	return updateKafkaTopic(ctx, tm.Config, d, topicSpec, paths)
 }
+
+// kafkaTopicConfigKeys limits the set of topic_config keys that are managed by Terraform.
+// Keys that are not managed are excluded from the update mask and their drift is suppressed,
+// so they can be tuned at runtime through Kafka admin tools.
+type kafkaTopicConfigKeys struct {
+	managed map[string]bool
+	ignored map[string]bool
+}
+
+func newKafkaTopicConfigKeys(managed, ignored interface{}) *kafkaTopicConfigKeys {
+	return &kafkaTopicConfigKeys{
+		managed: kafkaTopicConfigKeySet(managed),
+		ignored: kafkaTopicConfigKeySet(ignored),
+	}
+}
+
+func kafkaTopicConfigKeySet(v interface{}) map[string]bool {
+	var keys []interface{}
+	switch v := v.(type) {
+	case *schema.Set:
+		keys = v.List()
+	case []interface{}:
+		keys = v
+	}
+
+	result := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		result[key.(string)] = true
+	}
+	return result
+}
+
+// expandKafkaTopicConfigKeys reads managed_config_keys and ignore_config_keys of the topic
+// located at prefixKey ("" for yandex_mdb_kafka_topic, "topic.N." for the cluster topic block).
+func expandKafkaTopicConfigKeys(d *schema.ResourceData, prefixKey string) (*kafkaTopicConfigKeys, error) {
+	keys := newKafkaTopicConfigKeys(d.Get(prefixKey+"managed_config_keys"), d.Get(prefixKey+"ignore_config_keys"))
+	if len(keys.managed) > 0 && len(keys.ignored) > 0 {
+		return nil, fmt.Errorf("only one of %smanaged_config_keys and %signore_config_keys can be specified", prefixKey, prefixKey)
+	}
+	return keys, nil
+}
+
+func (k *kafkaTopicConfigKeys) isManaged(key string) bool {
+	if len(k.managed) > 0 {
+		return k.managed[key]
+	}
+	return !k.ignored[key]
+}
+
+func kafkaTopicConfigKeyNames() []string {
+	var names []string
+	for name := range resourceYandexMDBKafkaClusterTopicConfig().Schema {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func kafkaTopicConfigKeysSchema(conflictsWith ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Set:      schema.HashString,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(kafkaTopicConfigKeyNames(), false),
+		},
+		ConflictsWith: conflictsWith,
+	}
+}
+
+// suppressUnmanagedKafkaTopicConfigDiff suppresses the diff of topic_config keys which are not managed
+// according to managed_config_keys/ignore_config_keys of the enclosing topic.
+// Initial values are not suppressed so that they are still sent on topic creation.
+func suppressUnmanagedKafkaTopicConfigDiff(k, old, _ string, d *schema.ResourceData) bool {
+	const configPrefix = "topic_config.0."
+	if old == "" {
+		return false
+	}
+	idx := strings.LastIndex(k, configPrefix)
+	if idx < 0 {
+		return false
+	}
+	prefixKey, key := k[:idx], k[idx+len(configPrefix):]
+	keys := newKafkaTopicConfigKeys(d.Get(prefixKey+"managed_config_keys"), d.Get(prefixKey+"ignore_config_keys"))
+	return !keys.isManaged(key)
+}
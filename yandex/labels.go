@@ -0,0 +1,180 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const effectiveLabelsPropName = "effective_labels"
+
+// hasLabelsAttribute reports whether resource has a top-level user-configurable "labels" map.
+func hasLabelsAttribute(r *schema.Resource) bool {
+	s, ok := r.Schema["labels"]
+	if !ok {
+		return false
+	}
+	return s.Type == schema.TypeMap && s.Optional
+}
+
+// withDefaultLabels makes resource aware of provider-level `default_labels`.
+//
+// The resource gets a computed `effective_labels` attribute holding all labels present on the
+// resource in the cloud. Provider default labels are merged into `labels` before the resource
+// is created or updated, and the keys injected this way are stripped from `labels` on read,
+// so that the plan only shows the labels set in the resource configuration.
+func withDefaultLabels(r *schema.Resource) *schema.Resource {
+	r.Schema[effectiveLabelsPropName] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "All labels present on the resource, including the `default_labels` configured on the provider.",
+	}
+
+	if r.CustomizeDiff != nil {
+		r.CustomizeDiff = customdiff.All(r.CustomizeDiff, customizeDiffEffectiveLabels)
+	} else {
+		r.CustomizeDiff = customizeDiffEffectiveLabels
+	}
+
+	switch {
+	case r.CreateContext != nil:
+		r.CreateContext = wrapContextWithDefaultLabels(r.CreateContext, true)
+	case r.CreateWithoutTimeout != nil:
+		r.CreateWithoutTimeout = wrapContextWithDefaultLabels(r.CreateWithoutTimeout, true)
+	case r.Create != nil:
+		r.Create = wrapWithDefaultLabels(r.Create, true)
+	}
+
+	switch {
+	case r.UpdateContext != nil:
+		r.UpdateContext = wrapContextWithDefaultLabels(r.UpdateContext, true)
+	case r.UpdateWithoutTimeout != nil:
+		r.UpdateWithoutTimeout = wrapContextWithDefaultLabels(r.UpdateWithoutTimeout, true)
+	case r.Update != nil:
+		r.Update = wrapWithDefaultLabels(r.Update, true)
+	}
+
+	switch {
+	case r.ReadContext != nil:
+		r.ReadContext = wrapContextWithDefaultLabels(r.ReadContext, false)
+	case r.ReadWithoutTimeout != nil:
+		r.ReadWithoutTimeout = wrapContextWithDefaultLabels(r.ReadWithoutTimeout, false)
+	case r.Read != nil:
+		r.Read = wrapWithDefaultLabels(r.Read, false)
+	}
+
+	return r
+}
+
+func customizeDiffEffectiveLabels(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("labels") {
+		return d.SetNewComputed(effectiveLabelsPropName)
+	}
+
+	labels, _ := d.Get("labels").(map[string]interface{})
+	return d.SetNew(effectiveLabelsPropName, mergeDefaultLabels(providerDefaultLabels(meta), labels))
+}
+
+func wrapWithDefaultLabels(f crudFunc, merge bool) crudFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		configured, err := prepareDefaultLabels(d, meta, merge)
+		if err != nil {
+			return err
+		}
+		err = f(d, meta)
+		if setErr := setEffectiveLabels(d, meta, configured); setErr != nil && err == nil {
+			err = setErr
+		}
+		return err
+	}
+}
+
+func wrapContextWithDefaultLabels[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](f F, merge bool) F {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		configured, err := prepareDefaultLabels(d, meta, merge)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		diags := f(ctx, d, meta)
+		if err := setEffectiveLabels(d, meta, configured); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+// prepareDefaultLabels returns labels known to be set by the user. When merge is true,
+// `labels` are replaced with the effective ones so that the request sent to the API contains default labels too.
+func prepareDefaultLabels(d *schema.ResourceData, meta interface{}, merge bool) (map[string]interface{}, error) {
+	configured, _ := d.Get("labels").(map[string]interface{})
+	if !merge {
+		return configured, nil
+	}
+
+	if err := d.Set("labels", mergeDefaultLabels(providerDefaultLabels(meta), configured)); err != nil {
+		return nil, fmt.Errorf("error setting labels: %s", err)
+	}
+	return configured, nil
+}
+
+func setEffectiveLabels(d *schema.ResourceData, meta interface{}, configured map[string]interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	effective, _ := d.Get("labels").(map[string]interface{})
+	if err := d.Set(effectiveLabelsPropName, effective); err != nil {
+		return fmt.Errorf("error setting %s: %s", effectiveLabelsPropName, err)
+	}
+	if err := d.Set("labels", stripDefaultLabels(providerDefaultLabels(meta), effective, configured)); err != nil {
+		return fmt.Errorf("error setting labels: %s", err)
+	}
+	return nil
+}
+
+func providerDefaultLabels(meta interface{}) map[string]string {
+	config, ok := meta.(*Config)
+	if !ok || config == nil {
+		return nil
+	}
+	return config.DefaultLabels
+}
+
+// mergeDefaultLabels returns provider default labels overridden by the resource labels.
+func mergeDefaultLabels(defaults map[string]string, labels map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(defaults)+len(labels))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range labels {
+		merged[k] = v
+	}
+	return merged
+}
+
+// stripDefaultLabels removes from effective labels the ones injected by the provider,
+// i.e. having the default value and not set in the resource configuration.
+func stripDefaultLabels(defaults map[string]string, effective, configured map[string]interface{}) map[string]interface{} {
+	labels := make(map[string]interface{}, len(effective))
+	for k, v := range effective {
+		if _, ok := configured[k]; !ok {
+			if dv, ok := defaults[k]; ok && dv == v {
+				continue
+			}
+		}
+		labels[k] = v
+	}
+	return labels
+}
+
+// hasFieldChange is like d.HasChange, but also reports changes of `labels` caused by provider default labels.
+func hasFieldChange(d *schema.ResourceData, field string) bool {
+	if field == "labels" {
+		return d.HasChanges(field, effectiveLabelsPropName)
+	}
+	return d.HasChange(field)
+}
@@ -0,0 +1,128 @@
+package yandex
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestMergeDefaultLabels(t *testing.T) {
+	cases := []struct {
+		name     string
+		defaults map[string]string
+		labels   map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "no defaults",
+			defaults: nil,
+			labels:   map[string]interface{}{"my_key": "my_value"},
+			expected: map[string]interface{}{"my_key": "my_value"},
+		},
+		{
+			name:     "no labels",
+			defaults: map[string]string{"env": "prod"},
+			labels:   nil,
+			expected: map[string]interface{}{"env": "prod"},
+		},
+		{
+			name:     "resource labels take precedence",
+			defaults: map[string]string{"env": "prod", "team": "infra"},
+			labels:   map[string]interface{}{"env": "test", "my_key": "my_value"},
+			expected: map[string]interface{}{"env": "test", "team": "infra", "my_key": "my_value"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := mergeDefaultLabels(tc.defaults, tc.labels)
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestStripDefaultLabels(t *testing.T) {
+	cases := []struct {
+		name       string
+		defaults   map[string]string
+		effective  map[string]interface{}
+		configured map[string]interface{}
+		expected   map[string]interface{}
+	}{
+		{
+			name:       "injected labels are stripped",
+			defaults:   map[string]string{"env": "prod"},
+			effective:  map[string]interface{}{"env": "prod", "my_key": "my_value"},
+			configured: map[string]interface{}{"my_key": "my_value"},
+			expected:   map[string]interface{}{"my_key": "my_value"},
+		},
+		{
+			name:       "configured labels are kept",
+			defaults:   map[string]string{"env": "prod"},
+			effective:  map[string]interface{}{"env": "prod"},
+			configured: map[string]interface{}{"env": "prod"},
+			expected:   map[string]interface{}{"env": "prod"},
+		},
+		{
+			name:       "labels changed outside of terraform are kept",
+			defaults:   map[string]string{"env": "prod"},
+			effective:  map[string]interface{}{"env": "test", "other": "value"},
+			configured: map[string]interface{}{},
+			expected:   map[string]interface{}{"env": "test", "other": "value"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := stripDefaultLabels(tc.defaults, tc.effective, tc.configured)
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestWithDefaultLabels(t *testing.T) {
+	var requested map[string]interface{}
+	r := withDefaultLabels(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			requested = d.Get("labels").(map[string]interface{})
+			d.SetId("resource-id")
+			return nil
+		},
+	})
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"labels": map[string]interface{}{"env": "test", "my_key": "my_value"},
+	})
+	meta := &Config{DefaultLabels: map[string]string{"env": "prod", "team": "infra"}}
+
+	if diags := r.CreateContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("bad: %#v", diags)
+	}
+
+	expectedEffective := map[string]interface{}{"env": "test", "team": "infra", "my_key": "my_value"}
+	if !reflect.DeepEqual(requested, expectedEffective) {
+		t.Fatalf("Requested labels:\n\n%#v\n\nExpected:\n\n%#v\n", requested, expectedEffective)
+	}
+	if effective := d.Get(effectiveLabelsPropName); !reflect.DeepEqual(effective, expectedEffective) {
+		t.Fatalf("Effective labels:\n\n%#v\n\nExpected:\n\n%#v\n", effective, expectedEffective)
+	}
+
+	expectedLabels := map[string]interface{}{"env": "test", "my_key": "my_value"}
+	if labels := d.Get("labels"); !reflect.DeepEqual(labels, expectedLabels) {
+		t.Fatalf("Labels:\n\n%#v\n\nExpected:\n\n%#v\n", labels, expectedLabels)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os/exec"
 	"regexp"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
 )
@@ -63,10 +64,22 @@ func expandLockboxSecretVersionEntries(ctx context.Context, d *schema.ResourceDa
 		val.SetTextValue(v.(string))
 	}
 
+	textValueWO, err := getWriteOnlyString(d, cty.GetAttrPath("entries").IndexInt(indexes[0].(int)).GetAttr("text_value_wo"))
+	if err != nil {
+		return nil, err
+	}
+	if textValueWO != "" {
+		if val.GetTextValue() != "" {
+			// We must validate manually - https://github.com/hashicorp/terraform-plugin-sdk/issues/470
+			return nil, fmt.Errorf("key %v has both text_value and text_value_wo, but only one of those must be set", val.GetKey())
+		}
+		val.SetTextValue(textValueWO)
+	}
+
 	if execRaw, ok := d.GetOk(fmt.Sprintf("entries.%d.command.0", indexes...)); ok {
 		if val.GetTextValue() != "" {
 			// We must validate manually - https://github.com/hashicorp/terraform-plugin-sdk/issues/470
-			return nil, fmt.Errorf("key %v has both text_value and command, but only one of those must be set", val.GetKey())
+			return nil, fmt.Errorf("key %v has more than one of text_value, text_value_wo and command, but only one of those must be set", val.GetKey())
 		}
 		execMap := execRaw.(map[string]interface{})
 		result, err := resolveCommand(ctx, execMap)
@@ -272,7 +272,7 @@ func expandGreenplumUpdatePath(d *schema.ResourceData, settingNames []string) []
 
 	updatePath := []string{}
 	for field, path := range mdbGreenplumUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasFieldChange(d, field) {
 			updatePath = append(updatePath, path)
 		}
 	}
@@ -820,6 +820,31 @@ func flattenKafkaTopics(topics []*kafka.Topic) []map[string]interface{} {
 	return result
 }
 
+// keepKafkaTopicConfigKeys copies managed_config_keys and ignore_config_keys from the state,
+// as they are not stored in the cluster and exist only on Terraform side.
+func keepKafkaTopicConfigKeys(topics []map[string]interface{}, stateTopics []interface{}) []map[string]interface{} {
+	stateTopicsByName := map[string]map[string]interface{}{}
+	for _, t := range stateTopics {
+		if stateTopic, ok := t.(map[string]interface{}); ok {
+			stateTopicsByName[stateTopic["name"].(string)] = stateTopic
+		}
+	}
+
+	for _, topic := range topics {
+		stateTopic, ok := stateTopicsByName[topic["name"].(string)]
+		if !ok {
+			continue
+		}
+		for _, attr := range []string{"managed_config_keys", "ignore_config_keys"} {
+			if v, ok := stateTopic[attr]; ok {
+				topic[attr] = v
+			}
+		}
+	}
+
+	return topics
+}
+
 type TopicConfigSpec interface {
 	GetCompressionType() kafka.CompressionType
 	GetDeleteRetentionMs() *wrappers.Int64Value
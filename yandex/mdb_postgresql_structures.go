@@ -952,7 +952,7 @@ func expandPGParamsUpdatePath(d *schema.ResourceData, settingNames []string) []s
 
 	updatePath := []string{}
 	for field, path := range mdbPGUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasFieldChange(d, field) {
 			updatePath = append(updatePath, path)
 		}
 	}
@@ -0,0 +1,82 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
+)
+
+// pendingOperationAttribute holds the ID of the create operation Terraform stopped waiting for.
+// The SDKv2 resources have no private state, so the ID is kept in the computed attribute instead.
+const pendingOperationAttribute = "pending_operation_id"
+
+func pendingOperationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+}
+
+// pendingOperation returns the state of the waiter kept in the pending operation attribute of the resource.
+func pendingOperation(d *schema.ResourceData) waiter.PrivateState {
+	return waiter.AttributeState{
+		Get: func() string {
+			return d.Get(pendingOperationAttribute).(string)
+		},
+		Set: func(id string) error {
+			return d.Set(pendingOperationAttribute, id)
+		},
+	}
+}
+
+// resumableCreate wraps the create function waiting for the operation with the pending operation state.
+// If the waiting is interrupted after the resource ID is set, the resource is saved with a warning
+// instead of an error, which would taint it, and the next run resumes waiting in resumeCreateOperation.
+func resumableCreate(create schema.CreateFunc) schema.CreateContextFunc {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := create(d, meta); err != nil {
+			return interruptedCreateDiagnostics(d, err)
+		}
+		return nil
+	}
+}
+
+// interruptedCreateDiagnostics returns a warning if the create operation of the resource is still in progress
+// and the error otherwise.
+func interruptedCreateDiagnostics(d *schema.ResourceData, err error) diag.Diagnostics {
+	operationID := d.Get(pendingOperationAttribute).(string)
+	if d.Id() == "" || operationID == "" || !waiter.IsInterrupted(err) {
+		return diag.FromErr(err)
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Operation is still in progress",
+		Detail:   fmt.Sprintf("Waiting for operation %s will be resumed by the next Terraform run: %s", operationID, err),
+	}}
+}
+
+// resumeCreateOperation waits for the create operation interrupted on the previous run, if any.
+// The failed operation is only logged, reading the resource shows what it has left behind.
+func resumeCreateOperation(config *Config, d *schema.ResourceData) error {
+	if d.Get(pendingOperationAttribute).(string) == "" {
+		return nil
+	}
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	_, err := waiter.Resume(ctx, config.sdk, pendingOperation(d))
+	if waiter.IsInterrupted(err) {
+		return fmt.Errorf("error while waiting for operation to create %q: %w", d.Id(), err)
+	}
+	if err != nil {
+		log.Printf("[WARN] Operation to create %q has failed: %s", d.Id(), err)
+		return d.Set(pendingOperationAttribute, "")
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package yandex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
+)
+
+func testPendingOperationConfig(t *testing.T) (*fakecloud.Server, *Config) {
+	ctx := context.Background()
+
+	s, err := fakecloud.Start()
+	require.NoError(t, err)
+	t.Cleanup(s.Stop)
+
+	sdk, err := ycsdk.Build(ctx, ycsdk.Config{
+		Credentials: ycsdk.NewIAMTokenCredentials(fakecloud.Token),
+		Endpoint:    s.Endpoint(),
+		Plaintext:   true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sdk.Shutdown(ctx) })
+
+	return s, &Config{sdk: sdk, FolderID: s.FolderID, contextWithClientTraceID: ctx}
+}
+
+func testPendingOperationData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		pendingOperationAttribute: pendingOperationSchema(),
+	}, map[string]interface{}{"name": "network"})
+}
+
+// testNetworkCreate creates the network the same way the clusters are created, giving up waiting for the operation shortly.
+func testNetworkCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(100 * time.Millisecond)
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.VPC().Network().Create(ctx, &vpc.CreateNetworkRequest{
+		FolderId: config.FolderID,
+		Name:     d.Get("name").(string),
+	}))
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to create network: %s", err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return fmt.Errorf("Error while get network create operation metadata: %s", err)
+	}
+	d.SetId(protoMetadata.(*vpc.CreateNetworkMetadata).NetworkId)
+
+	err = waiter.Wait(ctx, op, pendingOperation(d))
+	if err != nil {
+		return fmt.Errorf("Error while waiting for operation to create network: %w", err)
+	}
+	return nil
+}
+
+func TestResumableCreate(t *testing.T) {
+	s, config := testPendingOperationConfig(t)
+	d := testPendingOperationData(t)
+
+	// The interrupted create keeps the resource with the pending operation.
+	s.HoldOperations()
+	diags := resumableCreate(testNetworkCreate)(context.Background(), d, config)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "Operation is still in progress", diags[0].Summary)
+	assert.NotEmpty(t, d.Id())
+	operationID := d.Get(pendingOperationAttribute).(string)
+	assert.NotEmpty(t, operationID)
+	assert.Contains(t, diags[0].Detail, operationID)
+
+	// The next run waits for the operation and clears it.
+	s.CompleteOperations()
+	require.NoError(t, resumeCreateOperation(config, d))
+	assert.Empty(t, d.Get(pendingOperationAttribute))
+
+	// There is nothing to wait for after that.
+	require.NoError(t, resumeCreateOperation(config, d))
+	assert.Empty(t, d.Get(pendingOperationAttribute))
+
+	// The completed create has no warnings.
+	d = testPendingOperationData(t)
+	assert.Empty(t, resumableCreate(testNetworkCreate)(context.Background(), d, config))
+	assert.NotEmpty(t, d.Id())
+	assert.Empty(t, d.Get(pendingOperationAttribute))
+}
+
+func TestResumableCreateError(t *testing.T) {
+	d := testPendingOperationData(t)
+
+	diags := resumableCreate(func(d *schema.ResourceData, _ interface{}) error {
+		d.SetId("cluster1")
+		return errors.New("create failed")
+	})(context.Background(), d, nil)
+
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Error, diags[0].Severity)
+	assert.Equal(t, "create failed", diags[0].Summary)
+}
+
+func TestResumeCreateOperationNotFound(t *testing.T) {
+	_, config := testPendingOperationConfig(t)
+	d := testPendingOperationData(t)
+	d.SetId("network1")
+	require.NoError(t, d.Set(pendingOperationAttribute, "unknown"))
+
+	// The operation which can't be found anymore is forgotten.
+	require.NoError(t, resumeCreateOperation(config, d))
+	assert.Empty(t, d.Get(pendingOperationAttribute))
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/yandex-cloud/terraform-provider-yandex/common/iambatch"
 	"github.com/yandex-cloud/terraform-provider-yandex/common/mutexkv"
 	"os"
 	"strconv"
 	"strings"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/ratelimit"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sharedcredentials"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/wif"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,6 +23,7 @@ import (
 
 // Global MutexKV
 var mutexKV = mutexkv.NewMutexKV()
+var iamBatcher = iambatch.NewBatcher(mutexKV)
 
 func NewSDKProvider() *schema.Provider {
 	return sdkProvider(false)
@@ -131,6 +136,82 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 				Optional:    true,
 				Description: common.Descriptions["profile"],
 			},
+			"default_labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: common.Descriptions["default_labels"],
+			},
+			"api_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: common.Descriptions["api_trace_file"],
+			},
+			"impersonate_service_account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: common.Descriptions["impersonate_service_account_id"],
+			},
+			"impersonate_service_account_delegates": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: common.Descriptions["impersonate_service_account_delegates"],
+			},
+			"workload_identity_federation": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: common.Descriptions["workload_identity_federation"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"token_file": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: common.Descriptions["workload_identity_federation_token_file"],
+						},
+						"service_account_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: common.Descriptions["workload_identity_federation_service_account_id"],
+						},
+						"endpoint": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: common.Descriptions["workload_identity_federation_endpoint"],
+						},
+					},
+				},
+			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: common.Descriptions["rate_limit"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: common.Descriptions["rate_limit_service"],
+						},
+						"requests_per_second": {
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Description: common.Descriptions["rate_limit_requests_per_second"],
+						},
+						"burst": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: common.Descriptions["rate_limit_burst"],
+						},
+						"max_concurrent_requests": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: common.Descriptions["rate_limit_max_concurrent_requests"],
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -165,6 +246,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_function":                                         dataSourceYandexFunction(),
 			"yandex_function_scaling_policy":                          dataSourceYandexFunctionScalingPolicy(),
 			"yandex_function_trigger":                                 dataSourceYandexFunctionTrigger(),
+			"yandex_iam_effective_bindings":                           dataSourceYandexIAMEffectiveBindings(),
 			"yandex_iam_policy":                                       dataSourceYandexIAMPolicy(),
 			"yandex_iam_role":                                         dataSourceYandexIAMRole(),
 			"yandex_iam_service_account":                              dataSourceYandexIAMServiceAccount(),
@@ -240,14 +322,24 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_cdn_resource":                                     resourceYandexCDNResource(),
 			"yandex_cm_certificate":                                   resourceYandexCMCertificate(),
 			"yandex_compute_disk":                                     resourceYandexComputeDisk(),
+			"yandex_compute_disk_iam_binding":                         resourceYandexComputeDiskIAMBinding(),
+			"yandex_compute_disk_iam_member":                          resourceYandexComputeDiskIAMMember(),
 			"yandex_compute_disk_placement_group":                     resourceYandexComputeDiskPlacementGroup(),
 			"yandex_compute_filesystem":                               resourceYandexComputeFilesystem(),
+			"yandex_compute_filesystem_iam_binding":                   resourceYandexComputeFilesystemIAMBinding(),
+			"yandex_compute_filesystem_iam_member":                    resourceYandexComputeFilesystemIAMMember(),
 			"yandex_compute_gpu_cluster":                              resourceYandexComputeGpuCluster(),
 			"yandex_compute_image":                                    resourceYandexComputeImage(),
+			"yandex_compute_image_iam_binding":                        resourceYandexComputeImageIAMBinding(),
+			"yandex_compute_image_iam_member":                         resourceYandexComputeImageIAMMember(),
 			"yandex_compute_instance":                                 resourceYandexComputeInstance(),
+			"yandex_compute_instance_iam_binding":                     resourceYandexComputeInstanceIAMBinding(),
+			"yandex_compute_instance_iam_member":                      resourceYandexComputeInstanceIAMMember(),
 			"yandex_compute_instance_group":                           resourceYandexComputeInstanceGroup(),
 			"yandex_compute_placement_group":                          resourceYandexComputePlacementGroup(),
 			"yandex_compute_snapshot":                                 resourceYandexComputeSnapshot(),
+			"yandex_compute_snapshot_iam_binding":                     resourceYandexComputeSnapshotIAMBinding(),
+			"yandex_compute_snapshot_iam_member":                      resourceYandexComputeSnapshotIAMMember(),
 			"yandex_compute_snapshot_schedule":                        resourceYandexComputeSnapshotSchedule(),
 			"yandex_dataproc_cluster":                                 resourceYandexDataprocCluster(),
 			"yandex_datatransfer_endpoint":                            resourceYandexDatatransferEndpoint(),
@@ -293,6 +385,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_mdb_kafka_topic":                                  resourceYandexMDBKafkaTopic(),
 			"yandex_mdb_kafka_connector":                              resourceYandexMDBKafkaConnector(),
 			"yandex_mdb_kafka_user":                                   resourceYandexMDBKafkaUser(),
+			"yandex_mdb_kafka_schema_registry_subject":                resourceYandexMDBKafkaSchemaRegistrySubject(),
 			"yandex_mdb_mongodb_cluster":                              resourceYandexMDBMongodbCluster(),
 			"yandex_mdb_mysql_cluster":                                resourceYandexMDBMySQLCluster(),
 			"yandex_mdb_mysql_database":                               resourceYandexMDBMySQLDatabase(),
@@ -343,6 +436,12 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 		},
 	}
 
+	for _, r := range provider.ResourcesMap {
+		if hasLabelsAttribute(r) {
+			withDefaultLabels(r)
+		}
+	}
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		return providerConfigure(ctx, d, provider, emptyFolder, false)
 	}
@@ -393,26 +492,49 @@ func setToDefaultIfNeeded(field string, osEnvName string, defaultVal string) str
 	return field
 }
 
+// stringOrDefault returns the value, or the default value if the value is empty.
+func stringOrDefault(value string, defaultVal string) string {
+	if value == "" {
+		return defaultVal
+	}
+	return value
+}
+
 func setToDefaultBoolIfNeeded(osEnvName string, defaultVal bool) bool {
-	field := os.Getenv(osEnvName)
-	v, err := strconv.ParseBool(field)
+	if defaultVal {
+		return defaultVal
+	}
+	v, err := strconv.ParseBool(os.Getenv(osEnvName))
 	if err != nil {
-		return v
-	} else {
 		return defaultVal
 	}
+	return v
 }
 
 // testConfig is used to avoid using StopContext duo to tests are run in parallel and context is cancelled randomly in tests
 // there is same following issue https://github.com/hashicorp/terraform-plugin-sdk/issues/966
 func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Provider, emptyFolder bool, testConfig bool) (interface{}, diag.Diagnostics) {
+	// The empty profile selects the current profile of the yc CLI config or the default one.
+	profile := d.Get("profile").(string)
+
+	// Settings of the shared credentials file profile take precedence over the defaults only,
+	// the provider arguments and the environment variables take precedence over them.
+	shared := &sharedcredentials.Credentials{}
+	if sharedCredentialsFile := d.Get("shared_credentials_file").(string); sharedCredentialsFile != "" {
+		var err error
+		sharedCredentialsProvider := sharedcredentials.Provider{Filename: sharedCredentialsFile, Profile: profile}
+		if shared, err = sharedCredentialsProvider.Retrieve(); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
 	config := Config{
-		Endpoint:                       setToDefaultIfNeeded(d.Get("endpoint").(string), "YC_ENDPOINT", common.DefaultEndpoint),
-		FolderID:                       setToDefaultIfNeeded(d.Get("folder_id").(string), "YC_FOLDER_ID", ""),
-		CloudID:                        setToDefaultIfNeeded(d.Get("cloud_id").(string), "YC_CLOUD_ID", ""),
+		Endpoint:                       setToDefaultIfNeeded(d.Get("endpoint").(string), "YC_ENDPOINT", stringOrDefault(shared.Endpoint, common.DefaultEndpoint)),
+		FolderID:                       setToDefaultIfNeeded(d.Get("folder_id").(string), "YC_FOLDER_ID", shared.FolderID),
+		CloudID:                        setToDefaultIfNeeded(d.Get("cloud_id").(string), "YC_CLOUD_ID", shared.CloudID),
 		OrganizationID:                 setToDefaultIfNeeded(d.Get("organization_id").(string), "YC_ORGANIZATION_ID", ""),
 		Region:                         setToDefaultIfNeeded(d.Get("region_id").(string), "YC_REGION", common.DefaultRegion),
-		Zone:                           setToDefaultIfNeeded(d.Get("zone").(string), "YC_ZONE", ""),
+		Zone:                           setToDefaultIfNeeded(d.Get("zone").(string), "YC_ZONE", shared.Zone),
 		Token:                          setToDefaultIfNeeded(d.Get("token").(string), "YC_TOKEN", ""),
 		ServiceAccountKeyFileOrContent: setToDefaultIfNeeded(d.Get("service_account_key_file").(string), "YC_SERVICE_ACCOUNT_KEY_FILE", ""),
 		StorageEndpoint:                setToDefaultIfNeeded(d.Get("storage_endpoint").(string), "YC_STORAGE_ENDPOINT_URL", common.DefaultStorageEndpoint),
@@ -426,12 +548,41 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		Insecure:              setToDefaultBoolIfNeeded("YC_INSECURE", d.Get("insecure").(bool)),
 		MaxRetries:            d.Get("max_retries").(int),
 		SharedCredentialsFile: d.Get("shared_credentials_file").(string),
-		Profile:               d.Get("profile").(string),
+		Profile:               profile,
+		APITraceFile:          setToDefaultIfNeeded(d.Get("api_trace_file").(string), "YC_API_TRACE_FILE", ""),
 		userAgent:             p.UserAgent("terraform-provider-yandex", version.ProviderVersion),
+
+		ImpersonateServiceAccountID: setToDefaultIfNeeded(d.Get("impersonate_service_account_id").(string), "YC_IMPERSONATE_SERVICE_ACCOUNT_ID", ""),
+	}
+
+	// Credentials of the profile are used as a whole, if the provider has none.
+	if config.Token == "" && config.ServiceAccountKeyFileOrContent == "" {
+		config.Token = shared.Token
+		config.ServiceAccountKeyFileOrContent = shared.ServiceAccountKeyFileOrContent
+	}
+	if config.YMQAccessKey == "" && config.YMQSecretKey == "" {
+		config.YMQAccessKey, config.YMQSecretKey = shared.YMQAccessKey, shared.YMQSecretKey
+	}
+
+	defaultLabels, err := expandLabels(d.Get("default_labels"))
+	if err != nil {
+		return nil, diag.FromErr(err)
 	}
+	config.DefaultLabels = defaultLabels
 
-	if len(config.Profile) == 0 {
-		config.Profile = "default"
+	for _, delegate := range d.Get("impersonate_service_account_delegates").([]interface{}) {
+		id, _ := delegate.(string)
+		config.ImpersonateServiceAccountDelegates = append(config.ImpersonateServiceAccountDelegates, id)
+	}
+
+	config.WorkloadIdentityFederation, err = expandWorkloadIdentityFederation(d.Get("workload_identity_federation").([]interface{}))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	config.RateLimits = expandRateLimits(d.Get("rate_limit").([]interface{}))
+	if err := ratelimit.Validate(config.RateLimits); err != nil {
+		return nil, diag.FromErr(err)
 	}
 
 	if config.MaxRetries == 0 {
@@ -482,3 +633,43 @@ func validateSAKey(v interface{}, k string) (warnings []string, errors []error)
 	}
 	return
 }
+
+func expandRateLimits(v []interface{}) []ratelimit.Limit {
+	var limits []ratelimit.Limit
+	for _, item := range v {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			// empty block
+			m = map[string]interface{}{}
+		}
+		limit := ratelimit.Limit{}
+		if service, ok := m["service"].(string); ok {
+			limit.Service = service
+		}
+		if rps, ok := m["requests_per_second"].(float64); ok {
+			limit.RequestsPerSecond = rps
+		}
+		if burst, ok := m["burst"].(int); ok {
+			limit.Burst = burst
+		}
+		if concurrency, ok := m["max_concurrent_requests"].(int); ok {
+			limit.MaxConcurrentRequests = concurrency
+		}
+		limits = append(limits, limit)
+	}
+	return limits
+}
+
+func expandWorkloadIdentityFederation(v []interface{}) (*wif.Credentials, error) {
+	if len(v) == 0 || v[0] == nil {
+		return nil, nil
+	}
+	m := v[0].(map[string]interface{})
+	tokenFile, _ := m["token_file"].(string)
+	serviceAccountID, _ := m["service_account_id"].(string)
+	endpoint, _ := m["endpoint"].(string)
+	if endpoint == "" {
+		endpoint = common.DefaultWIFEndpoint
+	}
+	return wif.New(tokenFile, serviceAccountID, endpoint)
+}
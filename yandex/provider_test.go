@@ -5,12 +5,17 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
 
 	terraform2 "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakecloud"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/ratelimit"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/replay"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -20,6 +25,7 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
 	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/grpc"
 )
 
 const providerDefaultValueInsecure = false
@@ -81,6 +87,12 @@ func init() {
 	}
 
 	if os.Getenv("TF_ACC") != "" {
+		if _, err := fakecloud.StartIfEnabled(); err != nil {
+			panic(err)
+		}
+		if _, err := replay.StartIfEnabled(); err != nil {
+			panic(err)
+		}
 		if err := setTestIDs(); err != nil {
 			panic(err)
 		}
@@ -197,6 +209,172 @@ func TestProviderOrganizationId(t *testing.T) {
 	assert.Equal(t, org, conf.OrganizationID)
 }
 
+func TestProviderRateLimits(t *testing.T) {
+	testProvider := NewSDKProvider()
+
+	raw := map[string]interface{}{
+		"token": "any_string_like_a_oauth",
+		"rate_limit": []interface{}{
+			map[string]interface{}{
+				"requests_per_second": 20,
+			},
+			map[string]interface{}{
+				"service":                 "iam",
+				"requests_per_second":     2.5,
+				"burst":                   5,
+				"max_concurrent_requests": 4,
+			},
+		},
+	}
+
+	diags := testProvider.Configure(context.Background(), terraform2.NewResourceConfigRaw(raw))
+	if diags != nil && diags.HasError() {
+		for _, d := range diags {
+			if d.Severity == diag.Error {
+				t.Fatalf("error configuring provider: %s", d.Summary)
+			}
+		}
+	}
+
+	conf := testProvider.Meta().(*Config)
+	assert.Equal(t, []ratelimit.Limit{
+		{RequestsPerSecond: 20},
+		{Service: "iam", RequestsPerSecond: 2.5, Burst: 5, MaxConcurrentRequests: 4},
+	}, conf.RateLimits)
+
+	raw["rate_limit"] = []interface{}{
+		map[string]interface{}{"service": "dns", "requests_per_second": 1},
+		map[string]interface{}{"service": "dns", "max_concurrent_requests": 1},
+	}
+	diags = NewSDKProvider().Configure(context.Background(), terraform2.NewResourceConfigRaw(raw))
+	assert.True(t, diags.HasError())
+}
+
+func TestProviderImpersonateServiceAccount(t *testing.T) {
+	testProvider := NewSDKProvider()
+
+	raw := map[string]interface{}{
+		"token":                                 "any_string_like_a_oauth",
+		"impersonate_service_account_id":        "ajetargetaccount",
+		"impersonate_service_account_delegates": []interface{}{"ajecidelegate", "ajefolderdelegate"},
+	}
+
+	diags := testProvider.Configure(context.Background(), terraform2.NewResourceConfigRaw(raw))
+	if diags != nil && diags.HasError() {
+		for _, d := range diags {
+			if d.Severity == diag.Error {
+				t.Fatalf("error configuring provider: %s", d.Summary)
+			}
+		}
+	}
+
+	conf := testProvider.Meta().(*Config)
+	assert.Equal(t, "ajetargetaccount", conf.ImpersonateServiceAccountID)
+	assert.Equal(t, []string{"ajecidelegate", "ajefolderdelegate"}, conf.ImpersonateServiceAccountDelegates)
+}
+
+func TestProviderSharedCredentialsProfilePrecedence(t *testing.T) {
+	for _, env := range []string{"YC_TOKEN", "YC_SERVICE_ACCOUNT_KEY_FILE", "YC_ENDPOINT", "YC_CLOUD_ID", "YC_FOLDER_ID",
+		"YC_ZONE", "YC_MESSAGE_QUEUE_ACCESS_KEY", "YC_MESSAGE_QUEUE_SECRET_KEY"} {
+		t.Setenv(env, "")
+	}
+
+	configure := func(raw map[string]interface{}) *Config {
+		testProvider := NewSDKProvider()
+		diags := testProvider.Configure(context.Background(), terraform2.NewResourceConfigRaw(raw))
+		for _, d := range diags {
+			if d.Severity == diag.Error {
+				t.Fatalf("error configuring provider: %s", d.Summary)
+			}
+		}
+		return testProvider.Meta().(*Config)
+	}
+
+	// The provider arguments take precedence over the environment variables,
+	// which take precedence over the profile, which takes precedence over the defaults.
+	t.Setenv("YC_ZONE", "ru-central1-a")
+	conf := configure(map[string]interface{}{
+		"shared_credentials_file": "test-fixtures/shared-credentials-file-full",
+		"profile":                 "ci",
+		"folder_id":               "provider-folder",
+	})
+	assert.Equal(t, "provider-folder", conf.FolderID)
+	assert.Equal(t, "ru-central1-a", conf.Zone)
+	assert.Equal(t, "profile-cloud", conf.CloudID)
+	assert.Equal(t, "api.example.net:443", conf.Endpoint)
+	assert.Equal(t, "profile-oauth-token", conf.Token)
+	assert.Equal(t, "profile-ymq-access-key", conf.YMQAccessKey)
+	assert.Equal(t, "profile-ymq-secret-key", conf.YMQSecretKey)
+	assert.Equal(t, common.DefaultRegion, conf.Region)
+
+	// The credentials of the profile are not mixed with the credentials of the provider.
+	conf = configure(map[string]interface{}{
+		"shared_credentials_file":  "test-fixtures/shared-credentials-file-full",
+		"profile":                  "ci",
+		"service_account_key_file": "test-fixtures/fake_service_account_key.json",
+	})
+	assert.Empty(t, conf.Token)
+	assert.Equal(t, "test-fixtures/fake_service_account_key.json", conf.ServiceAccountKeyFileOrContent)
+
+	conf = configure(map[string]interface{}{
+		"shared_credentials_file": "test-fixtures/yc-config.yaml",
+		"profile":                 "ci",
+	})
+	assert.Equal(t, "cli-oauth-token", conf.Token)
+	assert.Equal(t, "cli-cloud", conf.CloudID)
+	assert.Equal(t, "cli-folder", conf.FolderID)
+	assert.Equal(t, "ru-central1-a", conf.Zone)
+	assert.Equal(t, common.DefaultEndpoint, conf.Endpoint)
+
+	// The current profile of the yc CLI config is used if the profile is not set.
+	conf = configure(map[string]interface{}{
+		"shared_credentials_file": "test-fixtures/yc-config.yaml",
+	})
+	assert.Equal(t, "cli-oauth-token", conf.Token)
+	assert.Equal(t, "cli-folder", conf.FolderID)
+
+	diags := NewSDKProvider().Configure(context.Background(), terraform2.NewResourceConfigRaw(map[string]interface{}{
+		"token":                   "any_string_like_a_oauth",
+		"shared_credentials_file": "test-fixtures/yc-config.yaml",
+		"profile":                 "missing",
+	}))
+	assert.True(t, diags.HasError())
+}
+
+func TestProviderWorkloadIdentityFederation(t *testing.T) {
+	testProvider := NewSDKProvider()
+
+	raw := map[string]interface{}{
+		"workload_identity_federation": []interface{}{
+			map[string]interface{}{
+				"token_file":         "test-fixtures/oidc-token",
+				"service_account_id": "ajewifserviceaccount",
+			},
+		},
+	}
+
+	diags := testProvider.Configure(context.Background(), terraform2.NewResourceConfigRaw(raw))
+	if diags != nil && diags.HasError() {
+		for _, d := range diags {
+			if d.Severity == diag.Error {
+				t.Fatalf("error configuring provider: %s", d.Summary)
+			}
+		}
+	}
+
+	conf := testProvider.Meta().(*Config)
+	federation := conf.WorkloadIdentityFederation
+	if assert.NotNil(t, federation) {
+		assert.Equal(t, "test-fixtures/oidc-token", federation.TokenFile)
+		assert.Equal(t, "ajewifserviceaccount", federation.ServiceAccountID)
+		assert.Equal(t, common.DefaultWIFEndpoint, federation.Endpoint)
+	}
+
+	credentials, err := conf.credentials()
+	assert.NoError(t, err)
+	assert.Same(t, federation, credentials)
+}
+
 func TestProviderSharedCredentialsFileAndProfile(t *testing.T) {
 	testProvider := NewSDKProvider()
 
@@ -289,6 +467,7 @@ func setTestIDs() error {
 	if envEndpoint == "" {
 		envEndpoint = common.DefaultEndpoint
 	}
+	plaintext, _ := strconv.ParseBool(os.Getenv("YC_PLAINTEXT"))
 
 	providerConfig := &Config{
 		Token:                          os.Getenv("YC_TOKEN"),
@@ -302,11 +481,22 @@ func setTestIDs() error {
 	config := &ycsdk.Config{
 		Credentials: credentials,
 		Endpoint:    envEndpoint,
+		Plaintext:   plaintext,
 	}
 
 	ctx := context.Background()
 
-	sdk, err := ycsdk.Build(ctx, *config)
+	// Trace the calls to the file, so that they are recorded together with the calls of the provider.
+	var opts []grpc.DialOption
+	if traceFile := os.Getenv("YC_API_TRACE_FILE"); traceFile != "" {
+		traceInterceptor, err := logging.NewTraceFileInterceptor(traceFile)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.WithUnaryInterceptor(traceInterceptor))
+	}
+
+	sdk, err := ycsdk.Build(ctx, *config, opts...)
 	if err != nil {
 		return err
 	}
@@ -299,7 +299,7 @@ func resourceYandexApiGatewayUpdate(d *schema.ResourceData, meta interface{}) er
 		updatePaths = append(updatePaths, "description")
 	}
 
-	if d.HasChange("labels") {
+	if d.HasChanges("labels", effectiveLabelsPropName) {
 		updatePaths = append(updatePaths, "labels")
 	}
 
@@ -305,7 +305,7 @@ func resourceYandexComputeDiskUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	labelPropName := "labels"
-	if d.HasChange(labelPropName) {
+	if d.HasChanges(labelPropName, effectiveLabelsPropName) {
 		labelsProp, err := expandLabels(d.Get(labelPropName))
 		if err != nil {
 			return err
@@ -0,0 +1,18 @@
+package yandex
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceYandexComputeDiskIAMBinding() *schema.Resource {
+	return resourceIamBinding(
+		IamComputeDiskSchema,
+		newComputeDiskIamUpdater,
+		WithTimeout(
+			&schema.ResourceTimeout{
+				Default: schema.DefaultTimeout(yandexIAMComputeDiskDefaultTimeout),
+			}),
+		WithImporter(
+			&schema.ResourceImporter{
+				StateContext: iamBindingImport(computeDiskIDParseFunc),
+			}),
+	)
+}
@@ -0,0 +1,139 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+func importComputeDiskIDFunc(disk *compute.Disk, parts ...string) func(*terraform.State) (string, error) {
+	return func(s *terraform.State) (string, error) {
+		return strings.Join(append([]string{disk.Id}, parts...), " "), nil
+	}
+}
+
+func TestAccComputeDiskIamBinding_basic(t *testing.T) {
+	var disk compute.Disk
+	name := acctest.RandomWithPrefix("tf-disk")
+
+	role := "viewer"
+	userID := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDiskIamBinding(name, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeDiskExists("yandex_compute_disk.foobar", &disk),
+					testAccCheckComputeDiskIam("yandex_compute_disk.foobar", role, []string{userID}),
+				),
+			},
+			{
+				ResourceName:      "yandex_compute_disk_iam_binding.viewer",
+				ImportStateIdFunc: importComputeDiskIDFunc(&disk, role),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeDiskIamMember_basic(t *testing.T) {
+	var disk compute.Disk
+	name := acctest.RandomWithPrefix("tf-disk")
+
+	role := "viewer"
+	userID := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDiskIamMember(name, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeDiskExists("yandex_compute_disk.foobar", &disk),
+					testAccCheckComputeDiskIam("yandex_compute_disk.foobar", role, []string{userID}),
+				),
+			},
+			{
+				ResourceName:      "yandex_compute_disk_iam_member.viewer",
+				ImportStateIdFunc: importComputeDiskIDFunc(&disk, role, userID),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeDiskIamBinding(name, role, userID string) string {
+	return testAccComputeDiskIamBase(name) + fmt.Sprintf(`
+resource "yandex_compute_disk_iam_binding" "viewer" {
+  disk_id = yandex_compute_disk.foobar.id
+  role = "%s"
+  members = ["%s"]
+}
+`, role, userID)
+}
+
+func testAccComputeDiskIamMember(name, role, userID string) string {
+	return testAccComputeDiskIamBase(name) + fmt.Sprintf(`
+resource "yandex_compute_disk_iam_member" "viewer" {
+  disk_id = yandex_compute_disk.foobar.id
+  role = "%s"
+  member = "%s"
+}
+`, role, userID)
+}
+
+func testAccCheckComputeDiskIam(resourceName, role string, members []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("can't find %s in state", resourceName)
+		}
+
+		bindings, err := getComputeDiskAccessBindings(context.Background(), config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		var roleMembers []string
+		for _, binding := range bindings {
+			if binding.RoleId == role {
+				roleMembers = append(roleMembers, binding.Subject.Type+":"+binding.Subject.Id)
+			}
+		}
+		sort.Strings(members)
+		sort.Strings(roleMembers)
+
+		if reflect.DeepEqual(members, roleMembers) {
+			return nil
+		}
+
+		return fmt.Errorf("binding found but expected members is %v, got %v", members, roleMembers)
+	}
+}
+
+func testAccComputeDiskIamBase(name string) string {
+	return fmt.Sprintf(`
+resource "yandex_compute_disk" "foobar" {
+  name = "%s"
+  size = 4
+  type = "network-hdd"
+  zone = "ru-central1-a"
+}
+`, name)
+}
@@ -160,7 +160,7 @@ func resourceYandexComputeDiskPlacementGroupUpdate(d *schema.ResourceData, meta
 		UpdateMask:           &field_mask.FieldMask{},
 	}
 
-	if d.HasChange("labels") {
+	if d.HasChanges("labels", effectiveLabelsPropName) {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
 			return err
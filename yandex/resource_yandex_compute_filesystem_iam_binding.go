@@ -0,0 +1,18 @@
+package yandex
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceYandexComputeFilesystemIAMBinding() *schema.Resource {
+	return resourceIamBinding(
+		IamComputeFilesystemSchema,
+		newComputeFilesystemIamUpdater,
+		WithTimeout(
+			&schema.ResourceTimeout{
+				Default: schema.DefaultTimeout(yandexIAMComputeFilesystemDefaultTimeout),
+			}),
+		WithImporter(
+			&schema.ResourceImporter{
+				StateContext: iamBindingImport(computeFilesystemIDParseFunc),
+			}),
+	)
+}
@@ -0,0 +1,139 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+func importComputeFilesystemIDFunc(filesystem *compute.Filesystem, parts ...string) func(*terraform.State) (string, error) {
+	return func(s *terraform.State) (string, error) {
+		return strings.Join(append([]string{filesystem.Id}, parts...), " "), nil
+	}
+}
+
+func TestAccComputeFilesystemIamBinding_basic(t *testing.T) {
+	var filesystem compute.Filesystem
+	name := acctest.RandomWithPrefix("tf-filesystem")
+
+	role := "viewer"
+	userID := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeFilesystemIamBinding(name, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeFilesystemExists("yandex_compute_filesystem.foobar", &filesystem),
+					testAccCheckComputeFilesystemIam("yandex_compute_filesystem.foobar", role, []string{userID}),
+				),
+			},
+			{
+				ResourceName:      "yandex_compute_filesystem_iam_binding.viewer",
+				ImportStateIdFunc: importComputeFilesystemIDFunc(&filesystem, role),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeFilesystemIamMember_basic(t *testing.T) {
+	var filesystem compute.Filesystem
+	name := acctest.RandomWithPrefix("tf-filesystem")
+
+	role := "viewer"
+	userID := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeFilesystemIamMember(name, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeFilesystemExists("yandex_compute_filesystem.foobar", &filesystem),
+					testAccCheckComputeFilesystemIam("yandex_compute_filesystem.foobar", role, []string{userID}),
+				),
+			},
+			{
+				ResourceName:      "yandex_compute_filesystem_iam_member.viewer",
+				ImportStateIdFunc: importComputeFilesystemIDFunc(&filesystem, role, userID),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeFilesystemIamBinding(name, role, userID string) string {
+	return testAccComputeFilesystemIamBase(name) + fmt.Sprintf(`
+resource "yandex_compute_filesystem_iam_binding" "viewer" {
+  filesystem_id = yandex_compute_filesystem.foobar.id
+  role = "%s"
+  members = ["%s"]
+}
+`, role, userID)
+}
+
+func testAccComputeFilesystemIamMember(name, role, userID string) string {
+	return testAccComputeFilesystemIamBase(name) + fmt.Sprintf(`
+resource "yandex_compute_filesystem_iam_member" "viewer" {
+  filesystem_id = yandex_compute_filesystem.foobar.id
+  role = "%s"
+  member = "%s"
+}
+`, role, userID)
+}
+
+func testAccCheckComputeFilesystemIam(resourceName, role string, members []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("can't find %s in state", resourceName)
+		}
+
+		bindings, err := getComputeFilesystemAccessBindings(context.Background(), config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		var roleMembers []string
+		for _, binding := range bindings {
+			if binding.RoleId == role {
+				roleMembers = append(roleMembers, binding.Subject.Type+":"+binding.Subject.Id)
+			}
+		}
+		sort.Strings(members)
+		sort.Strings(roleMembers)
+
+		if reflect.DeepEqual(members, roleMembers) {
+			return nil
+		}
+
+		return fmt.Errorf("binding found but expected members is %v, got %v", members, roleMembers)
+	}
+}
+
+func testAccComputeFilesystemIamBase(name string) string {
+	return fmt.Sprintf(`
+resource "yandex_compute_filesystem" "foobar" {
+  name = "%s"
+  size = 10
+  type = "network-hdd"
+  zone = "ru-central1-a"
+}
+`, name)
+}
@@ -0,0 +1,18 @@
+package yandex
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceYandexComputeFilesystemIAMMember() *schema.Resource {
+	return resourceIamMember(
+		IamComputeFilesystemSchema,
+		newComputeFilesystemIamUpdater,
+		WithTimeout(
+			&schema.ResourceTimeout{
+				Default: schema.DefaultTimeout(yandexIAMComputeFilesystemDefaultTimeout),
+			}),
+		WithImporter(
+			&schema.ResourceImporter{
+				StateContext: iamMemberImport(computeFilesystemIDParseFunc),
+			}),
+	)
+}
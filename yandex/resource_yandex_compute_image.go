@@ -257,7 +257,7 @@ func resourceYandexComputeImageUpdate(d *schema.ResourceData, meta interface{})
 	d.Partial(true)
 
 	labelPropName := "labels"
-	if d.HasChange(labelPropName) {
+	if d.HasChanges(labelPropName, effectiveLabelsPropName) {
 		labelsProp, err := expandLabels(d.Get(labelPropName))
 		if err != nil {
 			return err
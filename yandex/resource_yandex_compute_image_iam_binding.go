@@ -0,0 +1,18 @@
+package yandex
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceYandexComputeImageIAMBinding() *schema.Resource {
+	return resourceIamBinding(
+		IamComputeImageSchema,
+		newComputeImageIamUpdater,
+		WithTimeout(
+			&schema.ResourceTimeout{
+				Default: schema.DefaultTimeout(yandexIAMComputeImageDefaultTimeout),
+			}),
+		WithImporter(
+			&schema.ResourceImporter{
+				StateContext: iamBindingImport(computeImageIDParseFunc),
+			}),
+	)
+}
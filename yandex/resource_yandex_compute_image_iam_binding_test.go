@@ -0,0 +1,137 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+func importComputeImageIDFunc(image *compute.Image, parts ...string) func(*terraform.State) (string, error) {
+	return func(s *terraform.State) (string, error) {
+		return strings.Join(append([]string{image.Id}, parts...), " "), nil
+	}
+}
+
+func TestAccComputeImageIamBinding_basic(t *testing.T) {
+	var image compute.Image
+	name := acctest.RandomWithPrefix("tf-image")
+
+	role := "viewer"
+	userID := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeImageIamBinding(name, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeImageExists("yandex_compute_image.foobar", &image),
+					testAccCheckComputeImageIam("yandex_compute_image.foobar", role, []string{userID}),
+				),
+			},
+			{
+				ResourceName:      "yandex_compute_image_iam_binding.viewer",
+				ImportStateIdFunc: importComputeImageIDFunc(&image, role),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeImageIamMember_basic(t *testing.T) {
+	var image compute.Image
+	name := acctest.RandomWithPrefix("tf-image")
+
+	role := "viewer"
+	userID := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeImageIamMember(name, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeImageExists("yandex_compute_image.foobar", &image),
+					testAccCheckComputeImageIam("yandex_compute_image.foobar", role, []string{userID}),
+				),
+			},
+			{
+				ResourceName:      "yandex_compute_image_iam_member.viewer",
+				ImportStateIdFunc: importComputeImageIDFunc(&image, role, userID),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeImageIamBinding(name, role, userID string) string {
+	return testAccComputeImageIamBase(name) + fmt.Sprintf(`
+resource "yandex_compute_image_iam_binding" "viewer" {
+  image_id = yandex_compute_image.foobar.id
+  role = "%s"
+  members = ["%s"]
+}
+`, role, userID)
+}
+
+func testAccComputeImageIamMember(name, role, userID string) string {
+	return testAccComputeImageIamBase(name) + fmt.Sprintf(`
+resource "yandex_compute_image_iam_member" "viewer" {
+  image_id = yandex_compute_image.foobar.id
+  role = "%s"
+  member = "%s"
+}
+`, role, userID)
+}
+
+func testAccCheckComputeImageIam(resourceName, role string, members []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("can't find %s in state", resourceName)
+		}
+
+		bindings, err := getComputeImageAccessBindings(context.Background(), config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		var roleMembers []string
+		for _, binding := range bindings {
+			if binding.RoleId == role {
+				roleMembers = append(roleMembers, binding.Subject.Type+":"+binding.Subject.Id)
+			}
+		}
+		sort.Strings(members)
+		sort.Strings(roleMembers)
+
+		if reflect.DeepEqual(members, roleMembers) {
+			return nil
+		}
+
+		return fmt.Errorf("binding found but expected members is %v, got %v", members, roleMembers)
+	}
+}
+
+func testAccComputeImageIamBase(name string) string {
+	return fmt.Sprintf(`
+resource "yandex_compute_image" "foobar" {
+  name          = "%s"
+  source_family = "ubuntu-1804-lts"
+}
+`, name)
+}
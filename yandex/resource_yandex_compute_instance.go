@@ -852,7 +852,7 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 	}
 
 	labelPropName := "labels"
-	if d.HasChange(labelPropName) {
+	if d.HasChanges(labelPropName, effectiveLabelsPropName) {
 		labelsProp, err := expandLabels(d.Get(labelPropName))
 		if err != nil {
 			return err
@@ -0,0 +1,18 @@
+package yandex
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceYandexComputeInstanceIAMBinding() *schema.Resource {
+	return resourceIamBinding(
+		IamComputeInstanceSchema,
+		newComputeInstanceIamUpdater,
+		WithTimeout(
+			&schema.ResourceTimeout{
+				Default: schema.DefaultTimeout(yandexIAMComputeInstanceDefaultTimeout),
+			}),
+		WithImporter(
+			&schema.ResourceImporter{
+				StateContext: iamBindingImport(computeInstanceIDParseFunc),
+			}),
+	)
+}
@@ -0,0 +1,128 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+func importComputeInstanceIDFunc(instance *compute.Instance, parts ...string) func(*terraform.State) (string, error) {
+	return func(s *terraform.State) (string, error) {
+		return strings.Join(append([]string{instance.Id}, parts...), " "), nil
+	}
+}
+
+func TestAccComputeInstanceIamBinding_basic(t *testing.T) {
+	var instance compute.Instance
+	name := acctest.RandomWithPrefix("tf-instance")
+
+	role := "viewer"
+	userID := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceIamBinding(name, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("yandex_compute_instance.foobar", &instance),
+					testAccCheckComputeInstanceIam("yandex_compute_instance.foobar", role, []string{userID}),
+				),
+			},
+			{
+				ResourceName:      "yandex_compute_instance_iam_binding.viewer",
+				ImportStateIdFunc: importComputeInstanceIDFunc(&instance, role),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeInstanceIamMember_basic(t *testing.T) {
+	var instance compute.Instance
+	name := acctest.RandomWithPrefix("tf-instance")
+
+	role := "viewer"
+	userID := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceIamMember(name, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("yandex_compute_instance.foobar", &instance),
+					testAccCheckComputeInstanceIam("yandex_compute_instance.foobar", role, []string{userID}),
+				),
+			},
+			{
+				ResourceName:      "yandex_compute_instance_iam_member.viewer",
+				ImportStateIdFunc: importComputeInstanceIDFunc(&instance, role, userID),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeInstanceIamBinding(name, role, userID string) string {
+	return testAccComputeInstance_basic(name) + fmt.Sprintf(`
+resource "yandex_compute_instance_iam_binding" "viewer" {
+  instance_id = yandex_compute_instance.foobar.id
+  role = "%s"
+  members = ["%s"]
+}
+`, role, userID)
+}
+
+func testAccComputeInstanceIamMember(name, role, userID string) string {
+	return testAccComputeInstance_basic(name) + fmt.Sprintf(`
+resource "yandex_compute_instance_iam_member" "viewer" {
+  instance_id = yandex_compute_instance.foobar.id
+  role = "%s"
+  member = "%s"
+}
+`, role, userID)
+}
+
+func testAccCheckComputeInstanceIam(resourceName, role string, members []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("can't find %s in state", resourceName)
+		}
+
+		bindings, err := getComputeInstanceAccessBindings(context.Background(), config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		var roleMembers []string
+		for _, binding := range bindings {
+			if binding.RoleId == role {
+				roleMembers = append(roleMembers, binding.Subject.Type+":"+binding.Subject.Id)
+			}
+		}
+		sort.Strings(members)
+		sort.Strings(roleMembers)
+
+		if reflect.DeepEqual(members, roleMembers) {
+			return nil
+		}
+
+		return fmt.Errorf("binding found but expected members is %v, got %v", members, roleMembers)
+	}
+}
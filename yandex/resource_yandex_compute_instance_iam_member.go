@@ -0,0 +1,18 @@
+package yandex
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceYandexComputeInstanceIAMMember() *schema.Resource {
+	return resourceIamMember(
+		IamComputeInstanceSchema,
+		newComputeInstanceIamUpdater,
+		WithTimeout(
+			&schema.ResourceTimeout{
+				Default: schema.DefaultTimeout(yandexIAMComputeInstanceDefaultTimeout),
+			}),
+		WithImporter(
+			&schema.ResourceImporter{
+				StateContext: iamMemberImport(computeInstanceIDParseFunc),
+			}),
+	)
+}
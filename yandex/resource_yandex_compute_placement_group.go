@@ -174,7 +174,7 @@ func resourceYandexComputePlacementGroupUpdate(d *schema.ResourceData, meta inte
 		UpdateMask:       &field_mask.FieldMask{},
 	}
 
-	if d.HasChange("labels") {
+	if d.HasChanges("labels", effectiveLabelsPropName) {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
 			return err
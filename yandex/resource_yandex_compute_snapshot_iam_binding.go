@@ -0,0 +1,18 @@
+package yandex
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceYandexComputeSnapshotIAMBinding() *schema.Resource {
+	return resourceIamBinding(
+		IamComputeSnapshotSchema,
+		newComputeSnapshotIamUpdater,
+		WithTimeout(
+			&schema.ResourceTimeout{
+				Default: schema.DefaultTimeout(yandexIAMComputeSnapshotDefaultTimeout),
+			}),
+		WithImporter(
+			&schema.ResourceImporter{
+				StateContext: iamBindingImport(computeSnapshotIDParseFunc),
+			}),
+	)
+}
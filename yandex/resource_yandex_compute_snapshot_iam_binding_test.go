@@ -0,0 +1,144 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+func importComputeSnapshotIDFunc(snapshot *compute.Snapshot, parts ...string) func(*terraform.State) (string, error) {
+	return func(s *terraform.State) (string, error) {
+		return strings.Join(append([]string{snapshot.Id}, parts...), " "), nil
+	}
+}
+
+func TestAccComputeSnapshotIamBinding_basic(t *testing.T) {
+	var snapshot compute.Snapshot
+	name := acctest.RandomWithPrefix("tf-snapshot")
+
+	role := "viewer"
+	userID := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeSnapshotIamBinding(name, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeSnapshotExists("yandex_compute_snapshot.foobar", &snapshot),
+					testAccCheckComputeSnapshotIam("yandex_compute_snapshot.foobar", role, []string{userID}),
+				),
+			},
+			{
+				ResourceName:      "yandex_compute_snapshot_iam_binding.viewer",
+				ImportStateIdFunc: importComputeSnapshotIDFunc(&snapshot, role),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeSnapshotIamMember_basic(t *testing.T) {
+	var snapshot compute.Snapshot
+	name := acctest.RandomWithPrefix("tf-snapshot")
+
+	role := "viewer"
+	userID := "system:allUsers"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeSnapshotIamMember(name, role, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeSnapshotExists("yandex_compute_snapshot.foobar", &snapshot),
+					testAccCheckComputeSnapshotIam("yandex_compute_snapshot.foobar", role, []string{userID}),
+				),
+			},
+			{
+				ResourceName:      "yandex_compute_snapshot_iam_member.viewer",
+				ImportStateIdFunc: importComputeSnapshotIDFunc(&snapshot, role, userID),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeSnapshotIamBinding(name, role, userID string) string {
+	return testAccComputeSnapshotIamBase(name) + fmt.Sprintf(`
+resource "yandex_compute_snapshot_iam_binding" "viewer" {
+  snapshot_id = yandex_compute_snapshot.foobar.id
+  role = "%s"
+  members = ["%s"]
+}
+`, role, userID)
+}
+
+func testAccComputeSnapshotIamMember(name, role, userID string) string {
+	return testAccComputeSnapshotIamBase(name) + fmt.Sprintf(`
+resource "yandex_compute_snapshot_iam_member" "viewer" {
+  snapshot_id = yandex_compute_snapshot.foobar.id
+  role = "%s"
+  member = "%s"
+}
+`, role, userID)
+}
+
+func testAccCheckComputeSnapshotIam(resourceName, role string, members []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("can't find %s in state", resourceName)
+		}
+
+		bindings, err := getComputeSnapshotAccessBindings(context.Background(), config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		var roleMembers []string
+		for _, binding := range bindings {
+			if binding.RoleId == role {
+				roleMembers = append(roleMembers, binding.Subject.Type+":"+binding.Subject.Id)
+			}
+		}
+		sort.Strings(members)
+		sort.Strings(roleMembers)
+
+		if reflect.DeepEqual(members, roleMembers) {
+			return nil
+		}
+
+		return fmt.Errorf("binding found but expected members is %v, got %v", members, roleMembers)
+	}
+}
+
+func testAccComputeSnapshotIamBase(name string) string {
+	return fmt.Sprintf(`
+resource "yandex_compute_disk" "foobar" {
+  name = "%s-disk"
+  size = 4
+  type = "network-hdd"
+  zone = "ru-central1-a"
+}
+
+resource "yandex_compute_snapshot" "foobar" {
+  name           = "%[1]s"
+  source_disk_id = yandex_compute_disk.foobar.id
+}
+`, name)
+}
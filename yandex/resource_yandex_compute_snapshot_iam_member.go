@@ -0,0 +1,18 @@
+package yandex
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceYandexComputeSnapshotIAMMember() *schema.Resource {
+	return resourceIamMember(
+		IamComputeSnapshotSchema,
+		newComputeSnapshotIamUpdater,
+		WithTimeout(
+			&schema.ResourceTimeout{
+				Default: schema.DefaultTimeout(yandexIAMComputeSnapshotDefaultTimeout),
+			}),
+		WithImporter(
+			&schema.ResourceImporter{
+				StateContext: iamMemberImport(computeSnapshotIDParseFunc),
+			}),
+	)
+}
@@ -231,7 +231,7 @@ func resourceYandexIoTCoreBrokerUpdate(d *schema.ResourceData, meta interface{})
 		updatePaths = append(updatePaths, "description")
 	}
 
-	if d.HasChange("labels") {
+	if d.HasChanges("labels", effectiveLabelsPropName) {
 		updatePaths = append(updatePaths, "labels")
 	}
 
@@ -182,7 +182,7 @@ func resourceYandexKMSAsymmetricSignatureKeyUpdate(d *schema.ResourceData, meta
 	d.Partial(true)
 
 	labelPropName := "labels"
-	if d.HasChange(labelPropName) {
+	if d.HasChanges(labelPropName, effectiveLabelsPropName) {
 		labelsProp, err := expandLabels(d.Get(labelPropName))
 		if err != nil {
 			return err
@@ -516,7 +516,7 @@ func resourceYandexKubernetesClusterUpdate(d *schema.ResourceData, meta interfac
 
 	var updatePath []string
 	for field, path := range updateKubernetesClusterFieldsMap {
-		if d.HasChange(field) {
+		if hasFieldChange(d, field) {
 			updatePath = append(updatePath, path)
 		}
 	}
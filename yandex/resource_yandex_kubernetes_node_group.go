@@ -1167,7 +1167,7 @@ func resourceYandexKubernetesNodeGroupUpdate(d *schema.ResourceData, meta interf
 
 	var updatePath []string
 	for field, path := range nodeGroupUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasFieldChange(d, field) {
 			updatePath = append(updatePath, path)
 		}
 	}
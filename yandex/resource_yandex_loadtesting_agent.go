@@ -438,7 +438,7 @@ func resourceYandexLoadtestingAgentUpdate(d *schema.ResourceData, meta interface
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "description")
 	}
 
-	if d.HasChange("labels") {
+	if d.HasChanges("labels", effectiveLabelsPropName) {
 		labels, err := expandLabels(d.Get("labels"))
 		if err != nil {
 			return err
@@ -53,6 +53,20 @@ func resourceYandexLockboxSecretVersion() *schema.Resource {
 							ValidateFunc: validation.StringLenBetween(0, 65536),
 						},
 
+						"text_value_wo": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							WriteOnly:    true,
+							ValidateFunc: validation.StringLenBetween(0, 65536),
+						},
+
+						"text_value_wo_version": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+
 						"command": {
 							Type:     schema.TypeList,
 							MaxItems: 1,
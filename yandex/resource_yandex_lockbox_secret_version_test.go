@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
 )
 
@@ -101,6 +103,68 @@ func TestAccLockboxVersion_update_entries(t *testing.T) {
 	})
 }
 
+func TestAccLockboxVersion_writeOnly(t *testing.T) {
+	secretName := "a" + acctest.RandString(10)
+	secretResource := "yandex_lockbox_secret.basic_secret"
+	versionResource := "yandex_lockbox_secret_version.basic_version"
+	versionID := ""
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckYandexLockboxSecretAllDestroyed,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Create secret and version with a write-only value
+				Config: testAccLockboxSecretVersionWriteOnly(secretName, "val1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckYandexLockboxResourceExists(secretResource, nil),
+					testAccCheckYandexLockboxResourceExists(versionResource, &versionID),
+					resource.TestCheckNoResourceAttr(versionResource, "entries.0.text_value_wo"),
+					resource.TestCheckResourceAttr(versionResource, "entries.0.text_value_wo_version", "1"),
+					testAccCheckYandexLockboxVersionEntries(versionResource, []*lockboxEntryCheck{
+						{Key: "key1", Val: "val1"},
+					}),
+				),
+			},
+			{
+				// changing the value alone does not create a new version
+				Config:   testAccLockboxSecretVersionWriteOnly(secretName, "val2", 1),
+				PlanOnly: true,
+			},
+			{
+				// bumping text_value_wo_version adds a new version with the new value
+				Config: testAccLockboxSecretVersionWriteOnly(secretName, "val2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckYandexLockboxResourceExists(versionResource, &versionID), // checks that now versionID is different
+					testAccCheckYandexLockboxVersionEntries(versionResource, []*lockboxEntryCheck{
+						{Key: "key1", Val: "val2"},
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccLockboxSecretVersionWriteOnly(name, value string, valueVersion int) string {
+	return fmt.Sprintf(`
+resource "yandex_lockbox_secret" "basic_secret" {
+  name = "%v"
+}
+
+resource "yandex_lockbox_secret_version" "basic_version" {
+  secret_id = yandex_lockbox_secret.basic_secret.id
+  entries {
+    key                   = "key1"
+    text_value_wo         = "%v"
+    text_value_wo_version = %v
+  }
+}
+`, name, value, valueVersion)
+}
+
 func testAccLockboxSecretVersionBasic(name, secretDesc, versionDesc string) string {
 	entries := []*lockboxEntryCheck{
 		{Key: "key1", Val: "val1"},
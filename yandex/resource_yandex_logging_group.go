@@ -196,7 +196,7 @@ func performYandexLoggingGroupUpdate(d *schema.ResourceData, config *Config, req
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "retention_period")
 	}
 
-	if d.HasChange("labels") {
+	if d.HasChanges("labels", effectiveLabelsPropName) {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
 			return err
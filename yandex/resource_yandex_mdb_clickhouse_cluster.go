@@ -15,6 +15,8 @@ import (
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
 )
 
 const (
@@ -230,10 +232,10 @@ var schemaConfig = map[string]*schema.Schema{
 
 func resourceYandexMDBClickHouseCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBClickHouseClusterCreate,
-		Read:   resourceYandexMDBClickHouseClusterRead,
-		Update: resourceYandexMDBClickHouseClusterUpdate,
-		Delete: resourceYandexMDBClickHouseClusterDelete,
+		CreateContext: resumableCreate(resourceYandexMDBClickHouseClusterCreate),
+		Read:          resourceYandexMDBClickHouseClusterRead,
+		Update:        resourceYandexMDBClickHouseClusterUpdate,
+		Delete:        resourceYandexMDBClickHouseClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -297,9 +299,10 @@ func resourceYandexMDBClickHouseCluster() *schema.Resource {
 				},
 			},
 			"user": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Set:      clickHouseUserHash,
+				Type:       schema.TypeSet,
+				Optional:   true,
+				Set:        clickHouseUserHash,
+				Deprecated: useResourceInstead("user", "yandex_mdb_clickhouse_user"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -507,9 +510,10 @@ func resourceYandexMDBClickHouseCluster() *schema.Resource {
 				},
 			},
 			"database": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Set:      clickHouseDatabaseHash,
+				Type:       schema.TypeSet,
+				Optional:   true,
+				Set:        clickHouseDatabaseHash,
+				Deprecated: useResourceInstead("database", "yandex_mdb_clickhouse_database"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -747,6 +751,7 @@ func resourceYandexMDBClickHouseCluster() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			pendingOperationAttribute: pendingOperationSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -893,9 +898,9 @@ func resourceYandexMDBClickHouseClusterCreate(d *schema.ResourceData, meta inter
 
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waiter.Wait(ctx, op, pendingOperation(d))
 	if err != nil {
-		return fmt.Errorf("error while waiting for operation to create ClickHouse Cluster: %s", err)
+		return fmt.Errorf("error while waiting for operation to create ClickHouse Cluster: %w", err)
 	}
 
 	if _, err := op.Response(); err != nil {
@@ -1093,6 +1098,10 @@ func prepareCreateClickHouseCreateRequest(d *schema.ResourceData, meta *Config)
 }
 
 func resourceYandexMDBClickHouseClusterRead(d *schema.ResourceData, meta interface{}) error {
+	if err := resumeCreateOperation(meta.(*Config), d); err != nil {
+		return err
+	}
+
 	log.Println("[DEBUG] cluster read started")
 	config := meta.(*Config)
 
@@ -1216,28 +1225,42 @@ func resourceYandexMDBClickHouseClusterRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	databases, err := listClickHouseDatabases(ctx, config, d.Id())
-	if err != nil {
-		return err
-	}
-	dbs := flattenClickHouseDatabases(databases)
-	if err := d.Set("database", dbs); err != nil {
-		return err
+	// Databases and users are not read into the deprecated blocks unless they are used,
+	// so that they can be managed by the separate resources.
+	if d.Get("database").(*schema.Set).Len() == 0 {
+		if err := d.Set("database", []map[string]interface{}{}); err != nil {
+			return err
+		}
+	} else {
+		databases, err := listClickHouseDatabases(ctx, config, d.Id())
+		if err != nil {
+			return err
+		}
+		dbs := flattenClickHouseDatabases(databases)
+		if err := d.Set("database", dbs); err != nil {
+			return err
+		}
 	}
 
-	dUsers, err := expandClickHouseUserSpecs(d)
-	if err != nil {
-		return err
-	}
-	passwords := clickHouseUsersPasswords(dUsers)
+	if d.Get("user").(*schema.Set).Len() == 0 {
+		if err := d.Set("user", []map[string]interface{}{}); err != nil {
+			return err
+		}
+	} else {
+		dUsers, err := expandClickHouseUserSpecs(d)
+		if err != nil {
+			return err
+		}
+		passwords := clickHouseUsersPasswords(dUsers)
 
-	users, err := listClickHouseUsers(ctx, config, d.Id())
-	if err != nil {
-		return err
-	}
-	us := flattenClickHouseUsers(users, passwords)
-	if err := d.Set("user", us); err != nil {
-		return err
+		users, err := listClickHouseUsers(ctx, config, d.Id())
+		if err != nil {
+			return err
+		}
+		us := flattenClickHouseUsers(users, passwords)
+		if err := d.Set("user", us); err != nil {
+			return err
+		}
 	}
 
 	if err := d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
@@ -1269,6 +1292,10 @@ func resourceYandexMDBClickHouseClusterRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceYandexMDBClickHouseClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resumeCreateOperation(meta.(*Config), d); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Started update ClickHouse Cluster %q", d.Id())
 	backupOriginalClusterResource(d)
 
@@ -1282,13 +1309,13 @@ func resourceYandexMDBClickHouseClusterUpdate(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	if d.HasChange("database") {
+	if d.HasChange("database") && d.Get("database").(*schema.Set).Len() > 0 {
 		if err := updateClickHouseClusterDatabases(d, meta); err != nil {
 			return err
 		}
 	}
 
-	if d.HasChange("user") {
+	if d.HasChange("user") && d.Get("user").(*schema.Set).Len() > 0 {
 		if err := updateClickHouseClusterUsers(d, meta); err != nil {
 			return err
 		}
@@ -1474,7 +1501,7 @@ func updateClickHouseClusterParams(d *schema.ResourceData, meta interface{}) err
 	onDone := []func(){}
 	updatePath := []string{}
 	for field, path := range mdbClickHouseUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasFieldChange(d, field) {
 			updatePath = append(updatePath, path)
 			onDone = append(onDone, func() {
 
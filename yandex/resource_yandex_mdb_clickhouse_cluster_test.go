@@ -110,6 +110,7 @@ func mdbClickHouseClusterImportStep(name string) resource.TestStep {
 		ImportStateVerify: true,
 		ImportStateVerifyIgnore: []string{
 			"user",                              // passwords are not returned
+			"database",                          // databases are not imported into the deprecated block
 			"host",                              // zookeeper hosts are not imported by default
 			"zookeeper",                         // zookeeper spec is not imported by default
 			"health",                            // volatile value
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/elasticsearch/v1"
 	"google.golang.org/genproto/protobuf/field_mask"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
 )
 
 const (
@@ -21,10 +23,10 @@ const (
 func resourceYandexMDBElasticsearchCluster() *schema.Resource {
 	return &schema.Resource{
 
-		Create: resourceYandexMDBElasticsearchClusterCreate,
-		Read:   resourceYandexMDBElasticsearchClusterRead,
-		Update: resourceYandexMDBElasticsearchClusterUpdate,
-		Delete: resourceYandexMDBElasticsearchClusterDelete,
+		CreateContext: resumableCreate(resourceYandexMDBElasticsearchClusterCreate),
+		Read:          resourceYandexMDBElasticsearchClusterRead,
+		Update:        resourceYandexMDBElasticsearchClusterUpdate,
+		Delete:        resourceYandexMDBElasticsearchClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -213,6 +215,7 @@ func resourceYandexMDBElasticsearchCluster() *schema.Resource {
 			},
 
 			// Creation timestamp.
+			pendingOperationAttribute: pendingOperationSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -303,6 +306,9 @@ var elasticsearchHostResource = &schema.Resource{
 
 func resourceYandexMDBElasticsearchClusterRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	if err := resumeCreateOperation(config, d); err != nil {
+		return err
+	}
 
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
 	defer cancel()
@@ -406,9 +412,9 @@ func resourceYandexMDBElasticsearchClusterCreate(d *schema.ResourceData, meta in
 
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waiter.Wait(ctx, op, pendingOperation(d))
 	if err != nil {
-		return fmt.Errorf("Error while waiting for operation to create Elasticsearch Cluster: %s", err)
+		return fmt.Errorf("Error while waiting for operation to create Elasticsearch Cluster: %w", err)
 	}
 
 	if _, err := op.Response(); err != nil {
@@ -509,6 +515,10 @@ func resourceYandexMDBElasticsearchClusterDelete(d *schema.ResourceData, meta in
 }
 
 func resourceYandexMDBElasticsearchClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resumeCreateOperation(meta.(*Config), d); err != nil {
+		return err
+	}
+
 	d.Partial(true)
 
 	if err := updateElasticsearchClusterParams(d, meta); err != nil {
@@ -617,7 +627,7 @@ func updateElasticsearchClusterParams(d *schema.ResourceData, meta interface{})
 		changed = append(changed, "name")
 	}
 
-	if d.HasChange("labels") {
+	if d.HasChanges("labels", effectiveLabelsPropName) {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
 			return err
@@ -11,6 +11,8 @@ import (
 	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
 )
 
 const (
@@ -22,10 +24,10 @@ const (
 
 func resourceYandexMDBGreenplumCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBGreenplumClusterCreate,
-		Read:   resourceYandexMDBGreenplumClusterRead,
-		Update: resourceYandexMDBGreenplumClusterUpdate,
-		Delete: resourceYandexMDBGreenplumClusterDelete,
+		CreateContext: resumableCreate(resourceYandexMDBGreenplumClusterCreate),
+		Read:          resourceYandexMDBGreenplumClusterRead,
+		Update:        resourceYandexMDBGreenplumClusterUpdate,
+		Delete:        resourceYandexMDBGreenplumClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -200,6 +202,7 @@ func resourceYandexMDBGreenplumCluster() *schema.Resource {
 				Required:  true,
 				Sensitive: true,
 			},
+			pendingOperationAttribute: pendingOperationSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -427,9 +430,9 @@ func resourceYandexMDBGreenplumClusterCreate(d *schema.ResourceData, meta interf
 	}
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waiter.Wait(ctx, op, pendingOperation(d))
 	if err != nil {
-		return fmt.Errorf("error while waiting for operation to create Greenplum Cluster: %s", err)
+		return fmt.Errorf("error while waiting for operation to create Greenplum Cluster: %w", err)
 	}
 	if _, err := op.Response(); err != nil {
 		return fmt.Errorf("failed to create Greenplum Cluster: %s", err)
@@ -517,6 +520,9 @@ func prepareCreateGreenplumClusterRequest(d *schema.ResourceData, meta *Config)
 
 func resourceYandexMDBGreenplumClusterRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	if err := resumeCreateOperation(config, d); err != nil {
+		return err
+	}
 
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
 	defer cancel()
@@ -679,6 +685,10 @@ func listGreenplumSegmentHosts(ctx context.Context, config *Config, id string) (
 }
 
 func resourceYandexMDBGreenplumClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resumeCreateOperation(meta.(*Config), d); err != nil {
+		return err
+	}
+
 	d.Partial(true)
 
 	config := meta.(*Config)
@@ -15,6 +15,8 @@ import (
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
 )
 
 const (
@@ -26,10 +28,10 @@ const (
 
 func resourceYandexMDBKafkaCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBKafkaClusterCreate,
-		Read:   resourceYandexMDBKafkaClusterRead,
-		Update: resourceYandexMDBKafkaClusterUpdate,
-		Delete: resourceYandexMDBKafkaClusterDelete,
+		CreateContext: resumableCreate(resourceYandexMDBKafkaClusterCreate),
+		Read:          resourceYandexMDBKafkaClusterRead,
+		Update:        resourceYandexMDBKafkaClusterUpdate,
+		Delete:        resourceYandexMDBKafkaClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -120,6 +122,7 @@ func resourceYandexMDBKafkaCluster() *schema.Resource {
 				Set:      kafkaHostHash,
 				Elem:     resourceYandexMDBKafkaHost(),
 			},
+			pendingOperationAttribute: pendingOperationSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -295,6 +298,8 @@ func resourceYandexMDBKafkaClusterTopicBlock() *schema.Resource {
 				MaxItems: 1,
 				Elem:     resourceYandexMDBKafkaClusterTopicConfig(),
 			},
+			"managed_config_keys": kafkaTopicConfigKeysSchema(),
+			"ignore_config_keys":  kafkaTopicConfigKeysSchema(),
 		},
 	}
 }
@@ -475,68 +480,81 @@ func resourceYandexMDBKafkaClusterTopicConfig() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"cleanup_policy": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validateParsableValue(parseKafkaTopicCleanupPolicy),
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressUnmanagedKafkaTopicConfigDiff,
+				ValidateFunc:     validateParsableValue(parseKafkaTopicCleanupPolicy),
 			},
 			"compression_type": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validateParsableValue(parseKafkaCompression),
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressUnmanagedKafkaTopicConfigDiff,
+				ValidateFunc:     validateParsableValue(parseKafkaCompression),
 			},
 			"delete_retention_ms": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: ConvertableToInt(),
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressUnmanagedKafkaTopicConfigDiff,
+				ValidateFunc:     ConvertableToInt(),
 			},
 			"file_delete_delay_ms": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: ConvertableToInt(),
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressUnmanagedKafkaTopicConfigDiff,
+				ValidateFunc:     ConvertableToInt(),
 			},
 			"flush_messages": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: ConvertableToInt(),
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressUnmanagedKafkaTopicConfigDiff,
+				ValidateFunc:     ConvertableToInt(),
 			},
 			"flush_ms": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: ConvertableToInt(),
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressUnmanagedKafkaTopicConfigDiff,
+				ValidateFunc:     ConvertableToInt(),
 			},
 			"min_compaction_lag_ms": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: ConvertableToInt(),
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressUnmanagedKafkaTopicConfigDiff,
+				ValidateFunc:     ConvertableToInt(),
 			},
 			"retention_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: ConvertableToInt(),
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressUnmanagedKafkaTopicConfigDiff,
+				ValidateFunc:     ConvertableToInt(),
 			},
 			"retention_ms": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: ConvertableToInt(),
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressUnmanagedKafkaTopicConfigDiff,
+				ValidateFunc:     ConvertableToInt(),
 			},
 			"max_message_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: ConvertableToInt(),
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressUnmanagedKafkaTopicConfigDiff,
+				ValidateFunc:     ConvertableToInt(),
 			},
 			"min_insync_replicas": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: ConvertableToInt(),
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressUnmanagedKafkaTopicConfigDiff,
+				ValidateFunc:     ConvertableToInt(),
 			},
 			"segment_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: ConvertableToInt(),
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressUnmanagedKafkaTopicConfigDiff,
+				ValidateFunc:     ConvertableToInt(),
 			},
 			"preallocate": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:             schema.TypeBool,
+				Optional:         true,
+				DiffSuppressFunc: suppressUnmanagedKafkaTopicConfigDiff,
 			},
 		},
 	}
@@ -618,9 +636,9 @@ func resourceYandexMDBKafkaClusterCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waiter.Wait(ctx, op, pendingOperation(d))
 	if err != nil {
-		return fmt.Errorf("error while waiting for operation to create Kafka Cluster: %s", err)
+		return fmt.Errorf("error while waiting for operation to create Kafka Cluster: %w", err)
 	}
 
 	if _, err := op.Response(); err != nil {
@@ -705,6 +723,9 @@ func prepareKafkaCreateRequest(d *schema.ResourceData, meta *Config) (*kafka.Cre
 
 func resourceYandexMDBKafkaClusterRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	if err := resumeCreateOperation(config, d); err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutRead))
 	defer cancel()
@@ -750,7 +771,7 @@ func resourceYandexMDBKafkaClusterRead(d *schema.ResourceData, meta interface{})
 		}
 		sortKafkaTopics(topics, topicSpecs)
 
-		if err := d.Set("topic", flattenKafkaTopics(topics)); err != nil {
+		if err := d.Set("topic", keepKafkaTopicConfigKeys(flattenKafkaTopics(topics), stateTopics)); err != nil {
 			return err
 		}
 	}
@@ -805,6 +826,10 @@ func resourceYandexMDBKafkaClusterRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceYandexMDBKafkaClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resumeCreateOperation(meta.(*Config), d); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Updating Kafka Cluster %q", d.Id())
 
 	d.Partial(true)
@@ -1020,7 +1045,7 @@ func kafkaClusterUpdateRequestWithMask(d *schema.ResourceData) (*kafka.UpdateClu
 
 	updatePath := []string{}
 	for field, path := range mdbKafkaUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasFieldChange(d, field) {
 			updatePath = append(updatePath, strings.Replace(path, "{version}", getSuffixVersion(d), -1))
 		}
 	}
@@ -1111,6 +1136,10 @@ func updateKafkaClusterTopics(d *schema.ResourceData, topicModifier KafkaTopicMo
 				return err
 			}
 			paths := kafkaTopicUpdateMask(topicDiff.OldEntity, topicDiff.NewEntity, getSuffixVersion(d))
+			if len(paths) == 0 {
+				log.Printf("[DEBUG] Topic %s has no changes in managed fields", topicName)
+				continue
+			}
 			if err := topicModifier.UpdateKafkaTopic(ctx, d, topicSpec, paths); err != nil {
 				return err
 			}
@@ -1356,13 +1385,18 @@ func kafkaTopicUpdateMask(oldTopic, newTopic map[string]interface{}, version str
 		keys[key] = struct{}{}
 	}
 
+	configKeys := newKafkaTopicConfigKeys(newTopic["managed_config_keys"], newTopic["ignore_config_keys"])
 	for key := range keys {
+		if !configKeys.isManaged(key) {
+			continue
+		}
 		val1 := oldTopicConfig[key]
 		val2 := newTopicConfig[key]
 		if !reflect.DeepEqual(val1, val2) {
 			paths = append(paths, fmt.Sprintf("topic_spec.topic_config_%s.%s", version, key))
 		}
 	}
+	sort.Strings(paths)
 
 	return paths
 }
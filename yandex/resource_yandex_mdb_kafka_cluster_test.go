@@ -1038,6 +1038,154 @@ func TestUpdateKafka3xClusterTopics(t *testing.T) {
 	}
 }
 
+func TestUpdateKafkaClusterTopicsWithConfigKeys(t *testing.T) {
+	rawInitial := map[string]interface{}{
+		"config": []interface{}{
+			map[string]interface{}{"version": "3.5"},
+		},
+		"topic": []interface{}{
+			map[string]interface{}{
+				"name":               "ignoringTopic",
+				"partitions":         1,
+				"replication_factor": 3,
+				"ignore_config_keys": []interface{}{"retention_ms"},
+				"topic_config": []interface{}{
+					map[string]interface{}{
+						"cleanup_policy": "CLEANUP_POLICY_DELETE",
+						"retention_ms":   "1000",
+					},
+				},
+			},
+			map[string]interface{}{
+				"name":                "managingTopic",
+				"partitions":          1,
+				"replication_factor":  3,
+				"managed_config_keys": []interface{}{"cleanup_policy"},
+				"topic_config": []interface{}{
+					map[string]interface{}{
+						"cleanup_policy": "CLEANUP_POLICY_DELETE",
+						"retention_ms":   "1000",
+					},
+				},
+			},
+			map[string]interface{}{
+				"name":               "driftedTopic",
+				"partitions":         1,
+				"replication_factor": 3,
+				"ignore_config_keys": []interface{}{"retention_ms"},
+				"topic_config": []interface{}{
+					map[string]interface{}{
+						"retention_ms": "1000",
+					},
+				},
+			},
+		},
+	}
+	diffAttributes := map[string]*terraform2.ResourceAttrDiff{
+		"topic.#":                               {Old: "3", New: "3"},
+		"topic.0.topic_config.#":                {Old: "1", New: "1"},
+		"topic.0.topic_config.0.cleanup_policy": {Old: "CLEANUP_POLICY_DELETE", New: "CLEANUP_POLICY_COMPACT"},
+		"topic.0.topic_config.0.retention_ms":   {Old: "1000", New: "2000"},
+		"topic.1.partitions":                    {Old: "1", New: "2"},
+		"topic.1.topic_config.#":                {Old: "1", New: "1"},
+		"topic.1.topic_config.0.cleanup_policy": {Old: "CLEANUP_POLICY_DELETE", New: "CLEANUP_POLICY_COMPACT"},
+		"topic.1.topic_config.0.retention_ms":   {Old: "1000", New: "2000"},
+		"topic.2.topic_config.#":                {Old: "1", New: "1"},
+		"topic.2.topic_config.0.retention_ms":   {Old: "1000", New: "2000"},
+	}
+	resourceData := CreateResourceData(t, resourceYandexMDBKafkaCluster().Schema, rawInitial, diffAttributes)
+
+	expectedPaths := map[string][]string{
+		"ignoringTopic": {"topic_spec.topic_config_3.cleanup_policy"},
+		"managingTopic": {"topic_spec.partitions", "topic_spec.topic_config_3.cleanup_policy"},
+	}
+
+	ctrl := gomock.NewController(t)
+	topicModifier := mocks.NewMockKafkaTopicModifier(ctrl)
+	topicModifier.EXPECT().UpdateKafkaTopic(gomock.Any(), resourceData, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, d *schema.ResourceData, topicSpec *kafka.TopicSpec, paths []string) error {
+			expected, ok := expectedPaths[topicSpec.GetName()]
+			require.True(t, ok, "unexpected update of topic %q", topicSpec.GetName())
+			require.Equal(t, expected, paths)
+			delete(expectedPaths, topicSpec.GetName())
+			return nil
+		}).Times(2)
+
+	err := updateKafkaClusterTopics(resourceData, topicModifier)
+
+	require.NoError(t, err)
+	require.Empty(t, expectedPaths)
+}
+
+func TestKafkaTopicUpdateMaskWithConfigKeys(t *testing.T) {
+	oldTopic := map[string]interface{}{
+		"partitions": 1,
+		"topic_config": []interface{}{
+			map[string]interface{}{
+				"cleanup_policy": "CLEANUP_POLICY_DELETE",
+				"retention_ms":   "1000",
+				"segment_bytes":  "1024",
+			},
+		},
+	}
+	newTopicConfig := []interface{}{
+		map[string]interface{}{
+			"cleanup_policy": "CLEANUP_POLICY_COMPACT",
+			"retention_ms":   "2000",
+			"segment_bytes":  "2048",
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		newTopic map[string]interface{}
+		expected []string
+	}{
+		{
+			name:     "all keys are managed by default",
+			newTopic: map[string]interface{}{"partitions": 1, "topic_config": newTopicConfig},
+			expected: []string{
+				"topic_spec.topic_config_3.cleanup_policy",
+				"topic_spec.topic_config_3.retention_ms",
+				"topic_spec.topic_config_3.segment_bytes",
+			},
+		},
+		{
+			name: "ignored keys are excluded",
+			newTopic: map[string]interface{}{
+				"partitions":         1,
+				"topic_config":       newTopicConfig,
+				"ignore_config_keys": schema.NewSet(schema.HashString, []interface{}{"retention_ms", "segment_bytes"}),
+			},
+			expected: []string{"topic_spec.topic_config_3.cleanup_policy"},
+		},
+		{
+			name: "only managed keys are included",
+			newTopic: map[string]interface{}{
+				"partitions":          2,
+				"topic_config":        newTopicConfig,
+				"managed_config_keys": schema.NewSet(schema.HashString, []interface{}{"segment_bytes"}),
+			},
+			expected: []string{"topic_spec.partitions", "topic_spec.topic_config_3.segment_bytes"},
+		},
+		{
+			name: "no managed changes",
+			newTopic: map[string]interface{}{
+				"partitions":          1,
+				"topic_config":        newTopicConfig,
+				"managed_config_keys": schema.NewSet(schema.HashString, []interface{}{"flush_ms"}),
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, kafkaTopicUpdateMask(oldTopic, tc.newTopic, "3"))
+		})
+	}
+}
+
 // Test that a Kafka Cluster can be created, updated and destroyed in single zone mode
 func TestAccMDBKafkaCluster_single(t *testing.T) {
 	t.Parallel()
@@ -0,0 +1,307 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/schemaregistry"
+)
+
+const (
+	yandexMDBKafkaSchemaRegistrySubjectDefaultTimeout = 5 * time.Minute
+
+	// Schema registry of the Managed Service for Apache Kafka® is served by every broker host on this port.
+	kafkaSchemaRegistryPort = 443
+)
+
+func resourceYandexMDBKafkaSchemaRegistrySubject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexMDBKafkaSchemaRegistrySubjectCreate,
+		Read:   resourceYandexMDBKafkaSchemaRegistrySubjectRead,
+		Update: resourceYandexMDBKafkaSchemaRegistrySubjectUpdate,
+		Delete: resourceYandexMDBKafkaSchemaRegistrySubjectDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBKafkaSchemaRegistrySubjectDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBKafkaSchemaRegistrySubjectDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexMDBKafkaSchemaRegistrySubjectDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBKafkaSchemaRegistrySubjectDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subject": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"schema": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"schema_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "AVRO",
+				ValidateFunc: validation.StringInSlice(schemaregistry.SchemaTypes, false),
+			},
+			"reference": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subject": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"compatibility_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(schemaregistry.CompatibilityLevels, false),
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"ca_certificate": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"schema_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceYandexMDBKafkaSchemaRegistrySubjectCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	client, err := kafkaSchemaRegistryClient(ctx, config, d)
+	if err != nil {
+		return err
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	subject := d.Get("subject").(string)
+
+	if level, ok := d.GetOk("compatibility_level"); ok {
+		log.Printf("[DEBUG] Setting compatibility level of Kafka schema registry subject %q to %s", subject, level)
+		if err := client.SetCompatibilityLevel(ctx, subject, level.(string)); err != nil {
+			return fmt.Errorf("error while setting compatibility level of subject %q in Kafka Cluster %q: %s", subject, clusterID, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Registering schema of Kafka schema registry subject %q", subject)
+	if _, err := client.RegisterSchema(ctx, subject, expandKafkaSchemaRegistrySchema(d)); err != nil {
+		return fmt.Errorf("error while registering schema of subject %q in Kafka Cluster %q: %s", subject, clusterID, err)
+	}
+
+	d.SetId(constructResourceId(clusterID, subject))
+	log.Printf("[DEBUG] Finished creating Kafka schema registry subject %q", subject)
+
+	return resourceYandexMDBKafkaSchemaRegistrySubjectRead(d, meta)
+}
+
+func resourceYandexMDBKafkaSchemaRegistrySubjectRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	clusterID, subject, err := deconstructResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := kafkaSchemaRegistryClient(ctx, config, d)
+	if err != nil {
+		return err
+	}
+
+	latest, err := client.GetLatestSchema(ctx, subject)
+	if schemaregistry.IsNotFound(err) {
+		log.Printf("[WARN] Removing Kafka schema registry subject %q because it doesn't exist anymore", subject)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error while reading subject %q in Kafka Cluster %q: %s", subject, clusterID, err)
+	}
+
+	level, err := client.GetCompatibilityLevel(ctx, subject)
+	if err != nil {
+		return fmt.Errorf("error while reading compatibility level of subject %q in Kafka Cluster %q: %s", subject, clusterID, err)
+	}
+
+	d.Set("cluster_id", clusterID)
+	d.Set("subject", subject)
+	d.Set("schema", latest.Schema)
+	d.Set("schema_type", latest.SchemaType)
+	d.Set("compatibility_level", level)
+	d.Set("schema_id", latest.ID)
+	d.Set("version", latest.Version)
+	return d.Set("reference", flattenKafkaSchemaRegistryReferences(latest.References))
+}
+
+func resourceYandexMDBKafkaSchemaRegistrySubjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	client, err := kafkaSchemaRegistryClient(ctx, config, d)
+	if err != nil {
+		return err
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	subject := d.Get("subject").(string)
+
+	// The compatibility level is changed first, so that the new schema is checked against it.
+	if d.HasChange("compatibility_level") {
+		level := d.Get("compatibility_level").(string)
+		log.Printf("[DEBUG] Setting compatibility level of Kafka schema registry subject %q to %s", subject, level)
+		if err := client.SetCompatibilityLevel(ctx, subject, level); err != nil {
+			return fmt.Errorf("error while setting compatibility level of subject %q in Kafka Cluster %q: %s", subject, clusterID, err)
+		}
+	}
+
+	if d.HasChanges("schema", "schema_type", "reference") {
+		log.Printf("[DEBUG] Registering new version of Kafka schema registry subject %q", subject)
+		if _, err := client.RegisterSchema(ctx, subject, expandKafkaSchemaRegistrySchema(d)); err != nil {
+			return fmt.Errorf("error while registering schema of subject %q in Kafka Cluster %q: %s", subject, clusterID, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating Kafka schema registry subject %q", subject)
+	return resourceYandexMDBKafkaSchemaRegistrySubjectRead(d, meta)
+}
+
+func resourceYandexMDBKafkaSchemaRegistrySubjectDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	client, err := kafkaSchemaRegistryClient(ctx, config, d)
+	if err != nil {
+		return err
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	subject := d.Get("subject").(string)
+
+	log.Printf("[DEBUG] Deleting Kafka schema registry subject %q", subject)
+	if err := client.DeleteSubject(ctx, subject, false); err != nil && !schemaregistry.IsNotFound(err) {
+		return fmt.Errorf("error while deleting subject %q from Kafka Cluster %q: %s", subject, clusterID, err)
+	}
+	if err := client.DeleteSubject(ctx, subject, true); err != nil && !schemaregistry.IsNotFound(err) {
+		return fmt.Errorf("error while permanently deleting subject %q from Kafka Cluster %q: %s", subject, clusterID, err)
+	}
+
+	log.Printf("[DEBUG] Finished deleting Kafka schema registry subject %q", subject)
+	return nil
+}
+
+// kafkaSchemaRegistryClient returns the client of the schema registry at the configured endpoint.
+// If the endpoint is not configured, the schema registry of the first broker host of the cluster is used.
+func kafkaSchemaRegistryClient(ctx context.Context, config *Config, d *schema.ResourceData) (*schemaregistry.Client, error) {
+	endpoint := d.Get("endpoint").(string)
+	if endpoint == "" {
+		clusterID := d.Get("cluster_id").(string)
+		hosts, err := listKafkaHosts(ctx, config, clusterID)
+		if err != nil {
+			return nil, err
+		}
+		for _, host := range hosts {
+			if host.GetRole() == kafka.Host_KAFKA {
+				endpoint = fmt.Sprintf("https://%s:%d", host.GetName(), kafkaSchemaRegistryPort)
+				break
+			}
+		}
+		if endpoint == "" {
+			return nil, fmt.Errorf("no broker hosts found in Kafka Cluster %q", clusterID)
+		}
+		if err := d.Set("endpoint", endpoint); err != nil {
+			return nil, err
+		}
+	}
+
+	return schemaregistry.New(endpoint, d.Get("username").(string), d.Get("password").(string),
+		[]byte(strings.TrimSpace(d.Get("ca_certificate").(string))))
+}
+
+func expandKafkaSchemaRegistrySchema(d *schema.ResourceData) *schemaregistry.Schema {
+	result := &schemaregistry.Schema{
+		Schema:     d.Get("schema").(string),
+		SchemaType: d.Get("schema_type").(string),
+	}
+	for _, r := range d.Get("reference").([]interface{}) {
+		reference := r.(map[string]interface{})
+		result.References = append(result.References, schemaregistry.Reference{
+			Name:    reference["name"].(string),
+			Subject: reference["subject"].(string),
+			Version: reference["version"].(int),
+		})
+	}
+	return result
+}
+
+func flattenKafkaSchemaRegistryReferences(references []schemaregistry.Reference) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(references))
+	for _, r := range references {
+		result = append(result, map[string]interface{}{
+			"name":    r.Name,
+			"subject": r.Subject,
+			"version": r.Version,
+		})
+	}
+	return result
+}
@@ -0,0 +1,119 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/fakeschemaregistry"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/schemaregistry"
+)
+
+const (
+	testKafkaSubjectSchemaV1 = `{"type":"record","name":"Order","fields":[{"name":"id","type":"long"}]}`
+	testKafkaSubjectSchemaV2 = `{"type":"record","name":"Order","fields":[{"name":"id","type":"long"},{"name":"note","type":"string","default":""}]}`
+)
+
+func testKafkaSchemaRegistrySubjectData(t *testing.T, server *fakeschemaregistry.Server, raw map[string]interface{}) *schema.ResourceData {
+	config := map[string]interface{}{
+		"cluster_id": "cluster1",
+		"subject":    "orders-value",
+		"username":   fakeschemaregistry.Username,
+		"password":   fakeschemaregistry.Password,
+		"endpoint":   server.URL,
+	}
+	for k, v := range raw {
+		config[k] = v
+	}
+	return schema.TestResourceDataRaw(t, resourceYandexMDBKafkaSchemaRegistrySubject().Schema, config)
+}
+
+func TestKafkaSchemaRegistrySubjectLifecycle(t *testing.T) {
+	server := fakeschemaregistry.Start()
+	t.Cleanup(server.Close)
+	config := &Config{contextWithClientTraceID: context.Background()}
+
+	d := testKafkaSchemaRegistrySubjectData(t, server, map[string]interface{}{
+		"schema":              testKafkaSubjectSchemaV1,
+		"compatibility_level": "FULL",
+	})
+	require.NoError(t, resourceYandexMDBKafkaSchemaRegistrySubjectCreate(d, config))
+
+	assert.Equal(t, "cluster1:orders-value", d.Id())
+	assert.Equal(t, "AVRO", d.Get("schema_type"))
+	assert.Equal(t, "FULL", d.Get("compatibility_level"))
+	assert.Equal(t, 1, d.Get("version"))
+	assert.Equal(t, "FULL", server.CompatibilityLevel("orders-value"))
+
+	// Update of the schema registers a new version of the subject.
+	updated := testKafkaSchemaRegistrySubjectData(t, server, map[string]interface{}{
+		"schema":              testKafkaSubjectSchemaV2,
+		"compatibility_level": "BACKWARD_TRANSITIVE",
+	})
+	updated.SetId(d.Id())
+	require.NoError(t, resourceYandexMDBKafkaSchemaRegistrySubjectUpdate(updated, config))
+
+	assert.Equal(t, 2, updated.Get("version"))
+	assert.Equal(t, "BACKWARD_TRANSITIVE", updated.Get("compatibility_level"))
+	latest, ok := server.LatestSchema("orders-value")
+	require.True(t, ok)
+	assert.Equal(t, testKafkaSubjectSchemaV2, latest.Schema)
+
+	require.NoError(t, resourceYandexMDBKafkaSchemaRegistrySubjectDelete(updated, config))
+	_, ok = server.LatestSchema("orders-value")
+	assert.False(t, ok)
+
+	// The subject deleted outside of Terraform is removed from the state.
+	require.NoError(t, resourceYandexMDBKafkaSchemaRegistrySubjectRead(updated, config))
+	assert.Equal(t, "", updated.Id())
+}
+
+func TestKafkaSchemaRegistrySubjectWithReferences(t *testing.T) {
+	server := fakeschemaregistry.Start()
+	t.Cleanup(server.Close)
+	config := &Config{contextWithClientTraceID: context.Background()}
+
+	common := testKafkaSchemaRegistrySubjectData(t, server, map[string]interface{}{
+		"subject":     "common.proto",
+		"schema_type": "PROTOBUF",
+		"schema":      `syntax = "proto3"; message Money { int64 units = 1; }`,
+	})
+	require.NoError(t, resourceYandexMDBKafkaSchemaRegistrySubjectCreate(common, config))
+
+	d := testKafkaSchemaRegistrySubjectData(t, server, map[string]interface{}{
+		"schema_type": "PROTOBUF",
+		"schema":      `syntax = "proto3"; import "common.proto"; message Order { Money total = 1; }`,
+		"reference": []interface{}{
+			map[string]interface{}{"name": "common.proto", "subject": "common.proto", "version": 1},
+		},
+	})
+	require.NoError(t, resourceYandexMDBKafkaSchemaRegistrySubjectCreate(d, config))
+
+	assert.Equal(t, "PROTOBUF", d.Get("schema_type"))
+	assert.Equal(t, fakeschemaregistry.GlobalCompatibilityLevel, d.Get("compatibility_level"))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "common.proto", "subject": "common.proto", "version": 1},
+	}, d.Get("reference"))
+
+	latest, ok := server.LatestSchema("orders-value")
+	require.True(t, ok)
+	assert.Equal(t, []schemaregistry.Reference{{Name: "common.proto", Subject: "common.proto", Version: 1}}, latest.References)
+}
+
+func TestKafkaSchemaRegistrySubjectCreateError(t *testing.T) {
+	server := fakeschemaregistry.Start()
+	t.Cleanup(server.Close)
+	config := &Config{contextWithClientTraceID: context.Background()}
+
+	d := testKafkaSchemaRegistrySubjectData(t, server, map[string]interface{}{
+		"schema":   testKafkaSubjectSchemaV1,
+		"password": "wrong",
+	})
+	err := resourceYandexMDBKafkaSchemaRegistrySubjectCreate(d, config)
+
+	require.ErrorContains(t, err, "status 401")
+	assert.Equal(t, "", d.Id())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -67,6 +68,8 @@ func resourceYandexMDBKafkaTopic() *schema.Resource {
 				MaxItems: 1,
 				Elem:     resourceYandexMDBKafkaClusterTopicConfig(),
 			},
+			"managed_config_keys": kafkaTopicConfigKeysSchema("ignore_config_keys"),
+			"ignore_config_keys":  kafkaTopicConfigKeysSchema("managed_config_keys"),
 		},
 	}
 }
@@ -132,6 +135,10 @@ func buildKafkaTopicSpec(d *schema.ResourceData, prefixKey string, version strin
 		return fmt.Sprintf("%s%s", prefixKey, key)
 	}
 
+	if _, err := expandKafkaTopicConfigKeys(d, prefixKey); err != nil {
+		return nil, err
+	}
+
 	topicName := d.Get(key("name")).(string)
 	topicSpec := &kafka.TopicSpec{
 		Name:              topicName,
@@ -227,16 +234,25 @@ func resourceYandexMDBKafkaTopicUpdate(d *schema.ResourceData, meta interface{})
 		TopicSpec: topicSpec,
 	}
 
+	configKeys, err := expandKafkaTopicConfigKeys(d, "")
+	if err != nil {
+		return err
+	}
+
 	var updatePath []string
 	versionPath := "3"
 	if strings.HasPrefix(version, "2") {
 		versionPath = strings.Replace(version, ".", "_", -1)
 	}
 	for field, path := range mdbKafkaTopicUpdateFieldsMap {
+		if key, ok := strings.CutPrefix(field, "topic_config.0."); ok && !configKeys.isManaged(key) {
+			continue
+		}
 		if d.HasChange(field) {
 			updatePath = append(updatePath, strings.Replace(path, "{version}", versionPath, -1))
 		}
 	}
+	sort.Strings(updatePath)
 	request.UpdateMask = &field_mask.FieldMask{Paths: updatePath}
 	if len(updatePath) == 0 {
 		return nil
@@ -18,6 +18,8 @@ import (
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
 )
 
 type key int
@@ -664,6 +666,7 @@ func resourceYandexMDBMongodbCluster() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			pendingOperationAttribute: pendingOperationSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -875,8 +878,11 @@ func resourceYandexMDBMongodbClusterCreate(ctx context.Context, d *schema.Resour
 
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waiter.Wait(ctx, op, pendingOperation(d))
 	if err != nil {
+		if waiter.IsInterrupted(err) {
+			return interruptedCreateDiagnostics(d, err)
+		}
 		return diag.Errorf("error while waiting for operation to create Mongodb Cluster: %s", err)
 	}
 
@@ -951,8 +957,11 @@ func resourceYandexMDBMongodbClusterRestore(d *schema.ResourceData, meta interfa
 
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waiter.Wait(ctx, op, pendingOperation(d))
 	if err != nil {
+		if waiter.IsInterrupted(err) {
+			return interruptedCreateDiagnostics(d, err)
+		}
 		return diag.Errorf("Error while waiting for operation to create MongoDB Cluster from backup %v: %s", backupID, err)
 	}
 
@@ -1025,6 +1034,9 @@ func listMongodbShards(ctx context.Context, config *Config, d *schema.ResourceDa
 
 func resourceYandexMDBMongodbClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
+	if err := resumeCreateOperation(config, d); err != nil {
+		return diag.FromErr(err)
+	}
 	cluster, err := config.sdk.MDB().MongoDB().Cluster().Get(ctx, &mongodb.GetClusterRequest{
 		ClusterId: d.Id(),
 	})
@@ -1177,6 +1189,10 @@ func sortMongoDBHosts(hosts []*mongodb.Host, specs []*mongodb.HostSpec) []*mongo
 }
 
 func resourceYandexMDBMongodbClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resumeCreateOperation(meta.(*Config), d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.Partial(true)
 
 	if err := setMongoDBFolderID(ctx, d, meta); err != nil {
@@ -1342,7 +1358,7 @@ func updateMongodbClusterParams(ctx context.Context, d *schema.ResourceData, met
 
 	var updatePath []string
 	for field, path := range mdbMongodbUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasFieldChange(d, field) {
 			updatePath = append(updatePath, path)
 		}
 	}
@@ -13,6 +13,8 @@ import (
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
 )
 
 const (
@@ -22,10 +24,10 @@ const (
 
 func resourceYandexMDBMySQLCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBMySQLClusterCreate,
-		Read:   resourceYandexMDBMySQLClusterRead,
-		Update: resourceYandexMDBMySQLClusterUpdate,
-		Delete: resourceYandexMDBMySQLClusterDelete,
+		CreateContext: resumableCreate(resourceYandexMDBMySQLClusterCreate),
+		Read:          resourceYandexMDBMySQLClusterRead,
+		Update:        resourceYandexMDBMySQLClusterUpdate,
+		Delete:        resourceYandexMDBMySQLClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -264,6 +266,7 @@ func resourceYandexMDBMySQLCluster() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			pendingOperationAttribute: pendingOperationSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -441,9 +444,9 @@ func resourceYandexMDBMySQLClusterCreate(d *schema.ResourceData, meta interface{
 	}
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waiter.Wait(ctx, op, pendingOperation(d))
 	if err != nil {
-		return fmt.Errorf("Error while waiting for operation to create MySQL Cluster: %s", err)
+		return fmt.Errorf("Error while waiting for operation to create MySQL Cluster: %w", err)
 	}
 	if _, err := op.Response(); err != nil {
 		return fmt.Errorf("MySQL Cluster creation failed: %s", err)
@@ -512,9 +515,9 @@ func resourceYandexMDBMySQLClusterRestore(d *schema.ResourceData, meta interface
 	}
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waiter.Wait(ctx, op, pendingOperation(d))
 	if err != nil {
-		return fmt.Errorf("Error while waiting for operation to create MySQL Cluster from backup %v: %s", backupID, err)
+		return fmt.Errorf("Error while waiting for operation to create MySQL Cluster from backup %v: %w", backupID, err)
 	}
 	if _, err := op.Response(); err != nil {
 		return fmt.Errorf("MySQL Cluster creation from backup %v failed: %s", backupID, err)
@@ -594,6 +597,9 @@ func prepareCreateMySQLRequest(d *schema.ResourceData, meta *Config) (*mysql.Cre
 
 func resourceYandexMDBMySQLClusterRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	if err := resumeCreateOperation(config, d); err != nil {
+		return err
+	}
 
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
 	defer cancel()
@@ -752,6 +758,9 @@ func resourceYandexMDBMySQLClusterRead(d *schema.ResourceData, meta interface{})
 
 func resourceYandexMDBMySQLClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	if err := resumeCreateOperation(config, d); err != nil {
+		return err
+	}
 	d.Partial(true)
 
 	err := validateClusterConfig(d)
@@ -889,7 +898,7 @@ func prepareMySQLClusterUpdateRequest(d *schema.ResourceData) (*mysql.UpdateClus
 
 	updatePaths := []string{}
 	for field, path := range mdbMysqlUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasFieldChange(d, field) {
 			updatePaths = append(updatePaths, path)
 		}
 	}
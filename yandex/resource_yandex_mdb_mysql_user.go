@@ -48,9 +48,22 @@ func resourceYandexMDBMySQLUser() *schema.Resource {
 				Required: true,
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+			},
+			"password_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
 			},
 			"permission": {
 				Type:     schema.TypeSet,
@@ -173,9 +186,11 @@ func expandMySQLUserSpec(d *schema.ResourceData) (*mysql.UserSpec, error) {
 		user.Name = v.(string)
 	}
 
-	if v, ok := d.GetOk("password"); ok {
-		user.Password = v.(string)
+	password, err := resolveWriteOnlyString(d, "password", "password_wo")
+	if err != nil {
+		return nil, err
 	}
+	user.Password = password
 
 	if v, ok := d.GetOk("permission"); ok {
 		permissions, err := expandMysqlUserPermissions(v.(*schema.Set))
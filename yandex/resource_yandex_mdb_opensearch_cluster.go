@@ -17,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"google.golang.org/genproto/protobuf/field_mask"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
 )
 
 const (
@@ -57,6 +59,7 @@ func resourceYandexMDBOpenSearchCluster() *schema.Resource {
 			},
 
 			// Creation timestamp.
+			pendingOperationAttribute: pendingOperationSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -379,6 +382,10 @@ func openSearchResourcesSchema() *schema.Resource {
 }
 
 func resourceYandexMDBOpenSearchClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resumeCreateOperation(meta.(*Config), d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := resourceYandexMDBOpenSearchClusterReadEx(ctx, d, meta, "ResourceRead"); err != nil {
 		return diag.FromErr(err)
 	}
@@ -523,8 +530,11 @@ func resourceYandexMDBOpenSearchClusterCreate(ctx context.Context, d *schema.Res
 
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waiter.Wait(ctx, op, pendingOperation(d))
 	if err != nil {
+		if waiter.IsInterrupted(err) {
+			return interruptedCreateDiagnostics(d, err)
+		}
 		return diag.Errorf("Error while waiting for operation to create OpenSearch Cluster: %s", err)
 	}
 
@@ -610,6 +620,10 @@ func resourceYandexMDBOpenSearchClusterDelete(ctx context.Context, d *schema.Res
 }
 
 func resourceYandexMDBOpenSearchClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resumeCreateOperation(meta.(*Config), d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	tflog.Debug(ctx, "Updating OpenSearch Cluster", map[string]interface{}{"id": d.Id()})
 
 	d.Partial(true)
@@ -831,7 +845,7 @@ func updateOpenSearchClusterParams(ctx context.Context, d *schema.ResourceData,
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "name")
 	}
 
-	if d.HasChange("labels") {
+	if d.HasChanges("labels", effectiveLabelsPropName) {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
 			return err
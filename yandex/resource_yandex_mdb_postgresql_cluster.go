@@ -15,6 +15,8 @@ import (
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
 )
 
 const (
@@ -25,10 +27,10 @@ const (
 
 func resourceYandexMDBPostgreSQLCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBPostgreSQLClusterCreate,
-		Read:   resourceYandexMDBPostgreSQLClusterRead,
-		Update: resourceYandexMDBPostgreSQLClusterUpdate,
-		Delete: resourceYandexMDBPostgreSQLClusterDelete,
+		CreateContext: resumableCreate(resourceYandexMDBPostgreSQLClusterCreate),
+		Read:          resourceYandexMDBPostgreSQLClusterRead,
+		Update:        resourceYandexMDBPostgreSQLClusterUpdate,
+		Delete:        resourceYandexMDBPostgreSQLClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -95,6 +97,7 @@ func resourceYandexMDBPostgreSQLCluster() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			pendingOperationAttribute: pendingOperationSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -507,6 +510,9 @@ func resourceYandexMDBPostgreSQLClusterMaintenanceWindow() *schema.Resource {
 
 func resourceYandexMDBPostgreSQLClusterRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	if err := resumeCreateOperation(config, d); err != nil {
+		return err
+	}
 
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
 	defer cancel()
@@ -689,9 +695,9 @@ func resourceYandexMDBPostgreSQLClusterCreate(d *schema.ResourceData, meta inter
 
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waiter.Wait(ctx, op, pendingOperation(d))
 	if err != nil {
-		return fmt.Errorf("Error while waiting for operation to create PostgreSQL Cluster: %s", err)
+		return fmt.Errorf("Error while waiting for operation to create PostgreSQL Cluster: %w", err)
 	}
 
 	if _, err := op.Response(); err != nil {
@@ -773,9 +779,9 @@ func resourceYandexMDBPostgreSQLClusterRestore(d *schema.ResourceData, meta inte
 
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waiter.Wait(ctx, op, pendingOperation(d))
 	if err != nil {
-		return fmt.Errorf("Error while waiting for operation to create PostgreSQL Cluster from backup %v: %s", backupID, err)
+		return fmt.Errorf("Error while waiting for operation to create PostgreSQL Cluster from backup %v: %w", backupID, err)
 	}
 
 	if _, err := op.Response(); err != nil {
@@ -918,6 +924,10 @@ func updatePGClusterAfterCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceYandexMDBPostgreSQLClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resumeCreateOperation(meta.(*Config), d); err != nil {
+		return err
+	}
+
 	d.Partial(true)
 
 	if err := setPGFolderID(d, meta); err != nil {
@@ -53,9 +53,22 @@ func resourceYandexMDBPostgreSQLUser() *schema.Resource {
 				Required: true,
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+			},
+			"password_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
 			},
 			"login": {
 				Type:     schema.TypeBool,
@@ -155,9 +168,11 @@ func expandPgUserSpec(d *schema.ResourceData) (*postgresql.UserSpec, error) {
 		user.Name = v.(string)
 	}
 
-	if v, ok := d.GetOkExists("password"); ok {
-		user.Password = v.(string)
+	password, err := resolveWriteOnlyString(d, "password", "password_wo")
+	if err != nil {
+		return nil, err
 	}
+	user.Password = password
 
 	if v, ok := d.GetOkExists("login"); ok {
 		user.Login = &wrappers.BoolValue{Value: v.(bool)}
@@ -258,7 +273,6 @@ func resourceYandexMDBPostgreSQLUserUpdate(d *schema.ResourceData, meta interfac
 
 	updatePath := []string{}
 	changeMask := map[string]string{
-		"password":   "password",
 		"permission": "permissions",
 		"login":      "login",
 		"grants":     "grants",
@@ -272,6 +286,12 @@ func resourceYandexMDBPostgreSQLUserUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
+	// The write-only password is never stored in the state, so its changes are tracked with password_wo_version.
+	// Switching from password to password_wo also changes password, in that case the new value is sent as well.
+	if d.HasChanges("password", "password_wo_version") && user.Password != "" {
+		updatePath = append(updatePath, "password")
+	}
+
 	if user.DeletionProtection != nil {
 		updatePath = append(updatePath, "deletion_protection")
 	}
@@ -6,9 +6,11 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/stretchr/testify/assert"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
 )
@@ -116,7 +118,8 @@ func mdbPostgreSQLUserImportStep(name string) resource.TestStep {
 		ImportState:       true,
 		ImportStateVerify: true,
 		ImportStateVerifyIgnore: []string{
-			"password", // password is not returned
+			"password",            // password is not returned
+			"password_wo_version", // write-only password is never stored
 		},
 	}
 }
@@ -291,3 +294,55 @@ resource "yandex_mdb_postgresql_user" "charlie" {
 	conn_limit = 0
 }`
 }
+
+// Test that a PostgreSQL User password can be moved to the write-only argument and rotated with its version
+func TestAccMDBPostgreSQLUser_writeOnlyPassword(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-postgresql-user-wo")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBPostgreSQLUserConfigStep1(clusterName),
+				Check:  resource.TestCheckResourceAttr(pgUserResourceNameAlice, "name", "alice"),
+			},
+			{
+				Config: testAccMDBPostgreSQLUserConfigWriteOnly(clusterName, "mysecureP@ssw0rd", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(pgUserResourceNameAlice, "password"),
+					resource.TestCheckNoResourceAttr(pgUserResourceNameAlice, "password_wo"),
+					resource.TestCheckResourceAttr(pgUserResourceNameAlice, "password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccMDBPostgreSQLUserConfigWriteOnly(clusterName, "myNewSecureP@ssw0rd", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(pgUserResourceNameAlice, "password_wo"),
+					resource.TestCheckResourceAttr(pgUserResourceNameAlice, "password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMDBPostgreSQLUserConfigWriteOnly(name, password string, passwordVersion int) string {
+	return testAccMDBPostgreSQLUserConfigStep0(name) + fmt.Sprintf(`
+resource "yandex_mdb_postgresql_user" "alice" {
+	cluster_id          = yandex_mdb_postgresql_cluster.foo.id
+	name                = "alice"
+	password_wo         = "%s"
+	password_wo_version = %d
+	login               = true
+	grants              = ["mdb_admin", "mdb_replication"]
+	conn_limit          = 50
+	settings = {
+		default_transaction_isolation = "read committed"
+		log_min_duration_statement    = 5000
+		pool_mode                     = "transaction"
+	}
+}`, password, passwordVersion)
+}
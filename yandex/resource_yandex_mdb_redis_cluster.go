@@ -16,6 +16,8 @@ import (
 	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
 )
 
 const (
@@ -27,10 +29,10 @@ const (
 
 func resourceYandexMDBRedisCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBRedisClusterCreate,
-		Read:   resourceYandexMDBRedisClusterRead,
-		Update: resourceYandexMDBRedisClusterUpdate,
-		Delete: resourceYandexMDBRedisClusterDelete,
+		CreateContext: resumableCreate(resourceYandexMDBRedisClusterCreate),
+		Read:          resourceYandexMDBRedisClusterRead,
+		Update:        resourceYandexMDBRedisClusterUpdate,
+		Delete:        resourceYandexMDBRedisClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -216,6 +218,7 @@ func resourceYandexMDBRedisCluster() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			pendingOperationAttribute: pendingOperationSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -298,9 +301,9 @@ func resourceYandexMDBRedisClusterCreate(d *schema.ResourceData, meta interface{
 	d.SetId(md.ClusterId)
 	log.Printf("[DEBUG] Creating Redis Cluster %q", md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waiter.Wait(ctx, op, pendingOperation(d))
 	if err != nil {
-		return fmt.Errorf("Error while waiting for operation to create Redis Cluster: %s", err)
+		return fmt.Errorf("Error while waiting for operation to create Redis Cluster: %w", err)
 	}
 
 	if _, err := op.Response(); err != nil {
@@ -394,6 +397,9 @@ func prepareCreateRedisRequest(d *schema.ResourceData, meta *Config) (*redis.Cre
 
 func resourceYandexMDBRedisClusterRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	if err := resumeCreateOperation(config, d); err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutRead))
 	defer cancel()
@@ -489,6 +495,10 @@ func resourceYandexMDBRedisClusterRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceYandexMDBRedisClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resumeCreateOperation(meta.(*Config), d); err != nil {
+		return err
+	}
+
 	d.Partial(true)
 
 	if err := setRedisFolderID(d, meta); err != nil {
@@ -553,7 +563,7 @@ func updateRedisClusterParams(d *schema.ResourceData, meta interface{}) error {
 
 	}
 
-	if d.HasChange("labels") {
+	if d.HasChanges("labels", effectiveLabelsPropName) {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
 			return err
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
 	"google.golang.org/genproto/protobuf/field_mask"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
 )
 
 const (
@@ -19,10 +21,10 @@ const (
 
 func resourceYandexMDBSQLServerCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexMDBSQLServerClusterCreate,
-		Read:   resourceYandexMDBSQLServerClusterRead,
-		Update: resourceYandexMDBSQLServerClusterUpdate,
-		Delete: resourceYandexMDBSQLServerClusterDelete,
+		CreateContext: resumableCreate(resourceYandexMDBSQLServerClusterCreate),
+		Read:          resourceYandexMDBSQLServerClusterRead,
+		Update:        resourceYandexMDBSQLServerClusterUpdate,
+		Delete:        resourceYandexMDBSQLServerClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -83,8 +85,9 @@ func resourceYandexMDBSQLServerCluster() *schema.Resource {
 				},
 			},
 			"database": {
-				Type:     schema.TypeList,
-				Required: true,
+				Type:       schema.TypeList,
+				Optional:   true,
+				Deprecated: useResourceInstead("database", "yandex_mdb_sqlserver_database"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -95,8 +98,9 @@ func resourceYandexMDBSQLServerCluster() *schema.Resource {
 				},
 			},
 			"user": {
-				Type:     schema.TypeList,
-				Required: true,
+				Type:       schema.TypeList,
+				Optional:   true,
+				Deprecated: useResourceInstead("user", "yandex_mdb_sqlserver_user"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -198,6 +202,7 @@ func resourceYandexMDBSQLServerCluster() *schema.Resource {
 				},
 			},
 
+			pendingOperationAttribute: pendingOperationSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -263,9 +268,9 @@ func resourceYandexMDBSQLServerClusterCreate(d *schema.ResourceData, meta interf
 	}
 	d.SetId(md.ClusterId)
 
-	err = op.Wait(ctx)
+	err = waiter.Wait(ctx, op, pendingOperation(d))
 	if err != nil {
-		return fmt.Errorf("Error while waiting for operation to create SQLServer Cluster: %s", err)
+		return fmt.Errorf("Error while waiting for operation to create SQLServer Cluster: %w", err)
 	}
 	if _, err := op.Response(); err != nil {
 		return fmt.Errorf("SQLServer Cluster creation failed: %s", err)
@@ -347,6 +352,9 @@ func prepareCreateSQLServerRequest(d *schema.ResourceData, meta *Config) (*sqlse
 
 func resourceYandexMDBSQLServerClusterRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	if err := resumeCreateOperation(config, d); err != nil {
+		return err
+	}
 
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
 	defer cancel()
@@ -375,23 +383,30 @@ func resourceYandexMDBSQLServerClusterRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	usersSpec, err := listSQLServerUsers(ctx, config, d.Id())
-	if err != nil {
-		return err
-	}
+	stateUsers := d.Get("user").([]interface{})
+	if len(stateUsers) == 0 {
+		if err := d.Set("user", []map[string]interface{}{}); err != nil {
+			return err
+		}
+	} else {
+		usersSpec, err := listSQLServerUsers(ctx, config, d.Id())
+		if err != nil {
+			return err
+		}
 
-	passwords := expandSQLServerUserPasswords(d)
+		passwords := expandSQLServerUserPasswords(d)
 
-	users, err := flattenSQLServerUsers(usersSpec, passwords)
+		users, err := flattenSQLServerUsers(usersSpec, passwords)
 
-	if err != nil {
-		return err
-	}
+		if err != nil {
+			return err
+		}
 
-	sortInterfaceListByResourceData(users, d, "user", "name")
+		sortInterfaceListByResourceData(users, d, "user", "name")
 
-	if err = d.Set("user", users); err != nil {
-		return err
+		if err = d.Set("user", users); err != nil {
+			return err
+		}
 	}
 	if err = d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
 		return err
@@ -412,17 +427,24 @@ func resourceYandexMDBSQLServerClusterRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	databasesSpec, err := listSQLServerDatabases(ctx, config, d.Id())
-	if err != nil {
-		return err
-	}
+	stateDatabases := d.Get("database").([]interface{})
+	if len(stateDatabases) == 0 {
+		if err := d.Set("database", []map[string]interface{}{}); err != nil {
+			return err
+		}
+	} else {
+		databasesSpec, err := listSQLServerDatabases(ctx, config, d.Id())
+		if err != nil {
+			return err
+		}
 
-	databases := flattenSQLServerDatabases(databasesSpec)
+		databases := flattenSQLServerDatabases(databasesSpec)
 
-	sortInterfaceListByResourceData(databases, d, "database", "name")
+		sortInterfaceListByResourceData(databases, d, "database", "name")
 
-	if err = d.Set("database", databases); err != nil {
-		return err
+		if err = d.Set("database", databases); err != nil {
+			return err
+		}
 	}
 
 	backupWindowStart := flattenMDBBackupWindowStart(cluster.GetConfig().GetBackupWindowStart())
@@ -518,6 +540,9 @@ func listSQLServerDatabases(ctx context.Context, config *Config, id string) ([]*
 
 func resourceYandexMDBSQLServerClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	if err := resumeCreateOperation(config, d); err != nil {
+		return err
+	}
 
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
 	defer cancel()
@@ -538,13 +563,15 @@ func resourceYandexMDBSQLServerClusterUpdate(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	if d.HasChange("database") {
+	stateDatabase := d.Get("database").([]interface{})
+	if d.HasChange("database") && len(stateDatabase) > 0 {
 		if err := sqlserverDatabaseUpdate(ctx, config, d); err != nil {
 			return err
 		}
 	}
 
-	if d.HasChange("user") {
+	stateUser := d.Get("user").([]interface{})
+	if d.HasChange("user") && len(stateUser) > 0 {
 		if err := sqlserverUserUpdate(ctx, config, d); err != nil {
 			return err
 		}
@@ -599,7 +626,7 @@ func sqlserverClusterUpdate(ctx context.Context, config *Config, d *schema.Resou
 
 	updatePath := []string{}
 	for field, path := range mdbSQLServerUpdateFieldsMap {
-		if d.HasChange(field) {
+		if hasFieldChange(d, field) {
 			updatePath = append(updatePath, path)
 		}
 	}
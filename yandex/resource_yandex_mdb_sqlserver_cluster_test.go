@@ -81,8 +81,9 @@ func mdbSQLServerClusterImportStep(name string) resource.TestStep {
 		ImportState:       true,
 		ImportStateVerify: true,
 		ImportStateVerifyIgnore: []string{
-			"user",   // passwords are not returned
-			"health", // volatile value
+			"user",     // passwords are not returned
+			"database", // databases are not imported into the deprecated block
+			"health",   // volatile value
 		},
 	}
 }
@@ -294,7 +294,7 @@ func resourceYandexOrganizationManagerSamlFederationUpdate(d *schema.ResourceDat
 
 	var updatePath []string
 	for field, path := range updateSamlFederationFieldsMap {
-		if d.HasChange(field) {
+		if hasFieldChange(d, field) {
 			updatePath = append(updatePath, path)
 		}
 	}
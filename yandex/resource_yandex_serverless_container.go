@@ -340,7 +340,7 @@ func resourceYandexServerlessContainerUpdate(d *schema.ResourceData, meta interf
 	if d.HasChange("description") {
 		updatePaths = append(updatePaths, "description")
 	}
-	if d.HasChange("labels") {
+	if d.HasChanges("labels", effectiveLabelsPropName) {
 		updatePaths = append(updatePaths, "labels")
 	}
 
@@ -272,7 +272,7 @@ func resourceYandexVPCAddressUpdateContext(ctx context.Context, d *schema.Resour
 	}
 
 	const addrLabelsPropName = "labels"
-	if d.HasChange(addrLabelsPropName) {
+	if d.HasChanges(addrLabelsPropName, effectiveLabelsPropName) {
 		labelsProp, err := expandLabels(d.Get(addrLabelsPropName))
 		if err != nil {
 			return diag.FromErr(err)
@@ -180,6 +180,50 @@ func TestAccVPCNetwork_update(t *testing.T) {
 		},
 	})
 }
+func TestAccVPCNetwork_defaultLabels(t *testing.T) {
+	t.Parallel()
+
+	var network vpc.Network
+	networkName := acctest.RandomWithPrefix("tf-network")
+	networkDesc := "Network description for test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetwork_defaultLabels(networkName, networkDesc, "default-value"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCNetworkExists("yandex_vpc_network.foo", &network),
+					resource.TestCheckResourceAttr("yandex_vpc_network.foo", "labels.%", "2"),
+					resource.TestCheckResourceAttr("yandex_vpc_network.foo", "labels.tf-label", "tf-label-value"),
+					resource.TestCheckResourceAttr("yandex_vpc_network.foo", "labels.overridden-label", "resource-value"),
+					resource.TestCheckResourceAttr("yandex_vpc_network.foo", "effective_labels.%", "3"),
+					resource.TestCheckResourceAttr("yandex_vpc_network.foo", "effective_labels.default-label", "default-value"),
+					resource.TestCheckResourceAttr("yandex_vpc_network.foo", "effective_labels.overridden-label", "resource-value"),
+					testAccCheckVPCNetworkContainsLabel(&network, "default-label", "default-value"),
+					testAccCheckVPCNetworkContainsLabel(&network, "overridden-label", "resource-value"),
+				),
+			},
+			{
+				Config: testAccVPCNetwork_defaultLabels(networkName, networkDesc, "updated-value"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCNetworkExists("yandex_vpc_network.foo", &network),
+					resource.TestCheckResourceAttr("yandex_vpc_network.foo", "labels.%", "2"),
+					resource.TestCheckResourceAttr("yandex_vpc_network.foo", "effective_labels.default-label", "updated-value"),
+					testAccCheckVPCNetworkContainsLabel(&network, "default-label", "updated-value"),
+				),
+			},
+			{
+				ResourceName:      "yandex_vpc_network.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccVPCNetwork_addSubnets(t *testing.T) {
 	t.Parallel()
 
@@ -321,6 +365,27 @@ resource "yandex_vpc_network" "foo" {
 `, name, description)
 }
 
+func testAccVPCNetwork_defaultLabels(name, description, defaultValue string) string {
+	return fmt.Sprintf(`
+provider "yandex" {
+  default_labels = {
+    default-label    = "%s"
+    overridden-label = "default-value"
+  }
+}
+
+resource "yandex_vpc_network" "foo" {
+  name        = "%s"
+  description = "%s"
+
+  labels = {
+    tf-label         = "tf-label-value"
+    overridden-label = "resource-value"
+  }
+}
+`, defaultValue, name, description)
+}
+
 func testAccVPCNetwork_addSubnets(name, description string) string {
 	return fmt.Sprintf(`
 resource "yandex_vpc_network" "foo" {
@@ -181,7 +181,7 @@ func resourceYandexVpcSecurityGroupRuleUpdate(data *schema.ResourceData, meta in
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "description")
 	}
 
-	if data.HasChange("labels") {
+	if data.HasChanges("labels", effectiveLabelsPropName) {
 		labelsProp, err := expandLabels(data.Get("labels"))
 		if err != nil {
 			return err
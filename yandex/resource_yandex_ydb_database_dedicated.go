@@ -370,7 +370,7 @@ func resourceYandexYDBDatabaseDedicatedUpdate(d *schema.ResourceData, meta inter
 func performYandexYDBDatabaseUpdate(d *schema.ResourceData, config *Config, req *ydb.UpdateDatabaseRequest) error {
 	d.Partial(true)
 	// common parameters
-	if d.HasChange("labels") {
+	if d.HasChanges("labels", effectiveLabelsPropName) {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
 			return err
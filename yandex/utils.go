@@ -8,13 +8,13 @@ import (
 	"fmt"
 	"log"
 	"net"
-	"regexp"
 	"sort"
 	"strings"
 	"text/template"
 	"time"
 
 	"github.com/c2h5oh/datasize"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -33,6 +33,8 @@ import (
 	ycsdk "github.com/yandex-cloud/go-sdk"
 	sdkoperation "github.com/yandex-cloud/go-sdk/operation"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/waiter"
 )
 
 type instanceAction int
@@ -228,34 +230,7 @@ func createTemporaryStaticAccessKey(roleID string, config *Config) (accessKey, s
 }
 
 func retryConflictingOperation(ctx context.Context, config *Config, action func() (*operation.Operation, error)) (*sdkoperation.Operation, error) {
-	for {
-		op, err := config.sdk.WrapOperation(action())
-		if err == nil {
-			return op, nil
-		}
-
-		operationID := ""
-		message := status.Convert(err).Message()
-		submatchGoApi := regexp.MustCompile(`conflicting operation "(.+)" detected`).FindStringSubmatch(message)
-		submatchPyApi := regexp.MustCompile(`Conflicting operation (.+) detected`).FindStringSubmatch(message)
-		if len(submatchGoApi) > 0 {
-			operationID = submatchGoApi[1]
-		} else if len(submatchPyApi) > 0 {
-			operationID = submatchPyApi[1]
-		} else {
-			return op, err
-		}
-
-		log.Printf("[DEBUG] Waiting for conflicting operation %q to complete", operationID)
-		req := &operation.GetOperationRequest{OperationId: operationID}
-		op, err = config.sdk.WrapOperation(config.sdk.Operation().Get(ctx, req))
-		if err != nil {
-			return nil, err
-		}
-
-		_ = op.Wait(ctx)
-		log.Printf("[DEBUG] Conflicting operation %q has completed. Going to retry initial action.", operationID)
-	}
+	return waiter.RetryConflictingOperation(ctx, config.sdk, action)
 }
 
 func handleNotFoundError(err error, d *schema.ResourceData, resourceName string) error {
@@ -635,6 +610,36 @@ func checkEveryOf(d *schema.ResourceData, keys ...string) error {
 	return nil
 }
 
+// getWriteOnlyString returns the value of a write-only attribute at the given path.
+// Write-only values are never stored in the plan or state, so they are only available in the raw config.
+func getWriteOnlyString(d *schema.ResourceData, path cty.Path) (string, error) {
+	v, diags := d.GetRawConfigAt(path)
+	if diags.HasError() {
+		return "", fmt.Errorf("failed to read write-only attribute: %s", diags[0].Summary)
+	}
+
+	if v.IsNull() || !v.IsKnown() || !v.Type().Equals(cty.String) {
+		return "", nil
+	}
+
+	return v.AsString(), nil
+}
+
+// resolveWriteOnlyString returns the value of the write-only attribute if it is set in the config,
+// and falls back to the value of its regular (persisted) counterpart otherwise.
+func resolveWriteOnlyString(d *schema.ResourceData, key string, writeOnlyKey string) (string, error) {
+	v, err := getWriteOnlyString(d, cty.GetAttrPath(writeOnlyKey))
+	if err != nil {
+		return "", err
+	}
+
+	if v != "" {
+		return v, nil
+	}
+
+	return d.Get(key).(string), nil
+}
+
 type objectResolverFunc func(name string, opts ...sdkresolvers.ResolveOption) ycsdk.Resolver
 
 // this function can be only used to resolve objects that belong to some folder (have folder_id attribute)
@@ -770,6 +775,8 @@ func convertResourceToDataSource(resource *schema.Resource) *schema.Resource {
 		schema.ForceNew = false
 		schema.Default = nil
 		schema.ValidateFunc = nil
+		schema.DiffSuppressFunc = nil
+		schema.ConflictsWith = nil
 		schema.MaxItems = 0
 		schema.MinItems = 0
 	})
@@ -864,7 +871,7 @@ func generateFieldMasks(d *schema.ResourceData, fieldsMap map[string]string) []s
 	changedPaths := make(map[string]bool)
 
 	for longField, longPath := range fieldsMap {
-		if !d.HasChange(longField) {
+		if !hasFieldChange(d, longField) {
 			continue
 		}
 
@@ -936,7 +943,7 @@ func generateDatatransferFieldMasksForPath(d *schema.ResourceData, node *fieldTr
 	terraformAttributePath := terraformPathPrefix + node.terraformAttributeName
 	protobufFieldPath := protobufPathPrefix + node.protobufFieldName
 
-	if !d.HasChange(terraformAttributePath) {
+	if !hasFieldChange(d, terraformAttributePath) {
 		return nil // No changes => empty field mask
 	}
 	// There's a change at terraformAttributePath. Try to refine it by recursing into the attribute